@@ -276,9 +276,13 @@ type AssertionStatement struct {
 	Token          Token
 	Constraint     *InvariantClause
 	Assume         bool
+	Quantifier     string // all or any instance of the stock/flow
 	Temporal       string
 	TemporalFilter string
 	TemporalN      int
+	TemporalM      int              // end of a between window
+	Until          *InvariantClause // what ends an until
+	Label          string           // names asserts Fault writes itself
 }
 
 func (as *AssertionStatement) statementNode()       {}
@@ -796,6 +800,8 @@ type Uncertain struct {
 	InferredType  *Type
 	Mean          float64
 	Sigma         float64
+	Distribution  string    // normal unless specified
+	Params        []float64 // as written, ie min and max for uniform
 	ProcessedName []string
 }
 
@@ -803,6 +809,11 @@ func (u *Uncertain) expressionNode()      {}
 func (u *Uncertain) TokenLiteral() string { return u.Token.Literal }
 func (u *Uncertain) String() string {
 	var out bytes.Buffer
+	if u.Distribution != "" && u.Distribution != "normal" {
+		out.WriteString("Distribution: ")
+		out.WriteString(u.Distribution)
+		out.WriteString(" ")
+	}
 	out.WriteString("Mean: ")
 	out.WriteString(strconv.FormatFloat(u.Mean, 'f', 6, 64))
 	out.WriteString("Sigma: ")
@@ -841,6 +852,7 @@ type Unknown struct {
 	Token         Token
 	InferredType  *Type
 	Name          *Identifier
+	Range         []float64 // min, max and step if bounded
 	ProcessedName []string
 }
 
@@ -852,6 +864,12 @@ func (u *Unknown) String() string {
 	if u.Name != nil { //This sometimes is set further up the tree and might be nil
 		out.WriteString(u.Name.Value)
 	}
+	for i, r := range u.Range {
+		if i > 0 || u.Name != nil {
+			out.WriteString(", ")
+		}
+		out.WriteString(strconv.FormatFloat(r, 'f', -1, 64))
+	}
 	out.WriteString(")")
 	return out.String()
 }
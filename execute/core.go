@@ -0,0 +1,238 @@
+package execute
+
+import (
+	"context"
+	"fault/smt"
+	"fault/util"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Why couldn't the model fail? Every assertion in the model
+// is named and the solver is asked which of them it needed
+// to prove there's no failure (the unsat core). Names are
+// then traced back to the asserts, assumes and variable
+// declarations in the spec.
+
+type CoreEntry struct {
+	Name     string `json:"name"` // :named in the SMT
+	Kind     string `json:"kind"` // assert, assume, init or rule
+	Variable string `json:"variable,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Rule     string `json:"rule,omitempty"`
+	Label    string `json:"label,omitempty"`
+}
+
+var namedRegex = regexp.MustCompile(`:named\s+([^\s()]+)`)
+
+func (c *CoreEntry) String(file string) string {
+	var where string
+	if c.Line > 0 {
+		where = " at " + specLine(file, c.Line)
+	}
+
+	switch c.Kind {
+	case "assert", "assume":
+		if c.Label != "" {
+			return c.Label
+		}
+		return fmt.Sprintf("%s%s", c.Kind, where)
+	case "init":
+		return fmt.Sprintf("initial value of %s%s", c.Variable, where)
+	default:
+		if c.Variable != "" {
+			return fmt.Sprintf("rule for %s%s %s", c.Variable, where, c.Rule)
+		}
+		if c.Rule == "" {
+			return fmt.Sprintf("rule %s", c.Name)
+		}
+		return fmt.Sprintf("rule %s", c.Rule)
+	}
+}
+
+func (mc *ModelChecker) Explain(ctx context.Context) ([]*CoreEntry, error) {
+	// Runs in its own solver, cores have to be turned
+	// on before anything is asserted
+	smt, rules := nameRules(mc.SMT)
+
+	s, err := newSession(mc.solver)
+	if err != nil {
+		return nil, err
+	}
+	defer s.close()
+
+	err = s.send(append(mc.solver.Options(), "(set-option :produce-unsat-cores true)", smt)...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.sync(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.send("(check-sat)")
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.readContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verdict, err := mc.solver.Verdict(response)
+	if err != nil {
+		return nil, err
+	}
+
+	if verdict != UNSAT { // Nothing to explain
+		return nil, nil
+	}
+
+	err = s.send("(get-unsat-core)")
+	if err != nil {
+		return nil, err
+	}
+
+	core, err := s.readContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*CoreEntry
+	for _, name := range util.SexprList(core) {
+		entries = append(entries, mc.coreEntries(name, rules)...)
+	}
+	mc.Core = entries
+	return entries, nil
+}
+
+func (mc *ModelChecker) coreEntries(name string, rules map[string]string) []*CoreEntry {
+	for _, p := range mc.Properties {
+		if p.Name == name {
+			kind := "assert"
+			if p.Assume {
+				kind = "assume"
+			}
+			return []*CoreEntry{{Name: name, Kind: kind, Line: p.Line(), Label: p.Label}}
+		}
+	}
+
+	rule, ok := rules[name]
+	if !ok {
+		return []*CoreEntry{{Name: name, Kind: "rule"}}
+	}
+
+	// Asserts are joined into one rule, each
+	// one in it is part of the reason
+	var entries []*CoreEntry
+	for _, m := range namedRegex.FindAllStringSubmatch(rule, -1) {
+		entries = append(entries, mc.coreEntries(m[1], nil)...)
+	}
+	if len(entries) > 0 {
+		return entries
+	}
+
+	e := &CoreEntry{Name: name, Kind: "rule", Rule: rule}
+	id, n := definedVar(rule)
+	if id != "" {
+		e.Variable = id
+		if mc.firstState(id, n) {
+			e.Kind = "init"
+		}
+		if pos, ok := mc.Positions[id]; ok && len(pos) > 0 {
+			e.Line = pos[0]
+		}
+	}
+	return []*CoreEntry{e}
+}
+
+func definedVar(rule string) (string, int) {
+	// The variable a rule sets is the one it's equal
+	// to, ie x_1 in (= x_1 (+ x_0 2.0)). Anything else
+	// bounds the latest state in it.
+	if parts := util.SexprList(rule); len(parts) == 3 && parts[0] == "=" {
+		if base, n, ok := ssaState(parts[1]); ok {
+			return base, n
+		}
+	}
+
+	var base string
+	n := -1
+	for _, id := range smt.SSARegex.FindAllString(rule, -1) {
+		if b, num, ok := ssaState(id); ok && num > n {
+			base, n = b, num
+		}
+	}
+	return base, n
+}
+
+func ssaState(id string) (string, int, bool) {
+	// x_1 is state 1 of x
+	if !smt.SSARegex.MatchString(id) {
+		return "", 0, false
+	}
+	i := strings.LastIndex(id, "_")
+	num, err := strconv.Atoi(id[i+1:])
+	if err != nil {
+		return "", 0, false
+	}
+	return id[:i], num, true
+}
+
+func (mc *ModelChecker) firstState(base string, n int) bool {
+	// Without the generator's states only x_0 is known
+	// to be first
+	states, ok := mc.VarRounds[base]
+	if !ok {
+		return n == 0
+	}
+	for _, s := range states {
+		if s[0] < n {
+			return false
+		}
+	}
+	return true
+}
+
+func (mc *ModelChecker) initRule(rule string) bool {
+	// Sets where something starts: every state in the
+	// rule is the first of its variable and at least
+	// one of the variables changes later
+	changes := false
+	for _, id := range smt.SSARegex.FindAllString(rule, -1) {
+		base, n, ok := ssaState(id)
+		if !ok {
+			continue
+		}
+		if !mc.firstState(base, n) {
+			return false
+		}
+		if len(mc.VarRounds[base]) > 1 {
+			changes = true
+		}
+	}
+	return changes
+}
+
+func nameRules(smt string) (string, map[string]string) {
+	// Names every assert that isn't named already
+	// so it can show up in the unsat core
+	rules := make(map[string]string)
+	var out []string
+	for _, cmd := range util.SexprList("(" + smt + ")") {
+		parts := util.SexprList(cmd)
+		if len(parts) != 2 || parts[0] != "assert" || strings.HasPrefix(parts[1], "(!") {
+			out = append(out, cmd)
+			continue
+		}
+
+		name := fmt.Sprintf("rule_%d", len(rules))
+		rules[name] = parts[1]
+		out = append(out, fmt.Sprintf("(assert (! %s :named %s))", parts[1], name))
+	}
+	return strings.Join(out, "\n"), rules
+}
@@ -0,0 +1,113 @@
+package execute
+
+import (
+	"context"
+	"fault/smt/properties"
+	"fault/smt/variables"
+	"strings"
+	"testing"
+)
+
+func TestNameRules(t *testing.T) {
+	smt := `(set-logic QF_NRA)(declare-fun x_0 () Real)
+(assert (= x_0 10.0))
+(assert (! (> x_0 5) :named assume_0))`
+
+	named, rules := nameRules(smt)
+	if len(rules) != 1 || rules["rule_0"] != "(= x_0 10.0)" {
+		t.Fatalf("rules named incorrectly. got=%v", rules)
+	}
+
+	if !strings.Contains(named, "(assert (! (= x_0 10.0) :named rule_0))") {
+		t.Fatalf("rule not named in the SMT. got=%s", named)
+	}
+
+	if strings.Count(named, ":named") != 2 || !strings.Contains(named, "(declare-fun x_0 () Real)") {
+		t.Fatalf("named SMT is malformed. got=%s", named)
+	}
+}
+
+func TestDefinedVar(t *testing.T) {
+	id, n := definedVar("(= test_x_2 (+ test_x_1 test_y_0))")
+	if id != "test_x" || n != 2 {
+		t.Fatalf("defined variable is incorrect. got=%s %d", id, n)
+	}
+
+	id, n = definedVar("(= test_y_0 (+ test_x_1 2.0))")
+	if id != "test_y" || n != 0 {
+		t.Fatalf("defined variable is incorrect. got=%s %d", id, n)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	replies := map[string]string{
+		"check-sat":      "echo unsat",
+		"get-unsat-core": `echo "(assume_0 rule_0 rule_1)"`,
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel(`(declare-fun x_0 () Real)
+(assert (= x_0 10.0))
+(assert (or (! (<= x_0 0) :named assert_0) (! (> x_0 20) :named assert_1)))
+(assert (! (> x_0 5) :named assume_0))`, make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	model.Properties = []*properties.Property{
+		{Name: "assert_0", Position: []int{20, 1}},
+		{Name: "assert_1", Position: []int{21, 1}},
+		{Name: "assume_0", Position: []int{22, 1}, Assume: true},
+	}
+	model.Positions = map[string][]int{"x": {4, 5}}
+	model.File = "bathtub.fspec"
+
+	core, err := model.Explain(context.Background())
+	if err != nil {
+		t.Fatalf("explaining the model failed. got=%s", err)
+	}
+
+	var got []string
+	for _, c := range core {
+		got = append(got, c.String(model.File))
+	}
+
+	want := []string{
+		"assume at bathtub.fspec:22",
+		"initial value of x at bathtub.fspec:4",
+		"assert at bathtub.fspec:20",
+		"assert at bathtub.fspec:21",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unsat core explained incorrectly.\nwant=%v\ngot=%v", want, got)
+	}
+
+	if summary := model.Summary(); len(summary.Core) != 4 {
+		t.Fatalf("core missing from summary. got=%v", summary.Core)
+	}
+}
+
+func TestSMTExplain(t *testing.T) {
+	model := prepSolverTest(t, `(declare-fun x_0 () Real)
+(assert (= x_0 10.0))
+(assert (! (<= x_0 0.0) :named assert_0))
+(assert (! (> x_0 5.0) :named assume_0))`)
+	model.Properties = []*properties.Property{
+		{Name: "assert_0", Position: []int{20, 1}},
+		{Name: "assume_0", Position: []int{22, 1}, Assume: true},
+	}
+	model.Positions = map[string][]int{"x": {4, 5}}
+	model.File = "bathtub.fspec"
+
+	core, err := model.Explain(context.Background())
+	if err != nil {
+		t.Fatalf("explaining the model failed. got=%s", err)
+	}
+
+	// Cores aren't always minimal, the assume may be in it
+	got := make(map[string]bool)
+	for _, c := range core {
+		got[c.String(model.File)] = true
+	}
+
+	for _, want := range []string{"initial value of x at bathtub.fspec:4", "assert at bathtub.fspec:20"} {
+		if !got[want] {
+			t.Fatalf("unsat core is missing %s. got=%v", want, got)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package execute
+
+import (
+	"context"
+	"errors"
+	"fault/smt"
+	"fault/smt/forks"
+	"fault/util"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Which way did a scenario go? Parallel runs try every order
+// of their calls and conditionals have two branches, the model
+// settles on one of each. Every option has a test, the one that
+// holds while the model is loaded is the option taken. Race
+// conditions only show up here: the values in a scenario don't
+// say which call ran first.
+
+type Decision struct {
+	Round int      `json:"round"` // of the run block, 0 is the setup before it
+	Kind  string   `json:"kind"`  // parallel or if
+	Taken []string `json:"taken"` // more than one when the options end the same
+}
+
+func (d *Decision) String() string {
+	return fmt.Sprintf("round %d: %s", d.Round, strings.Join(d.Taken, " or "))
+}
+
+func (mc *ModelChecker) Decisions(results map[string]Scenario) []*Decision {
+	// Decisions made when the scenario was solved
+	return mc.decided[scenarioKey(mc.keptValues(results))]
+}
+
+func (mc *ModelChecker) decide(ctx context.Context) ([]*Decision, error) {
+	var tests []string
+	for _, d := range mc.decisions {
+		tests = append(tests, d.Tests...)
+	}
+	if len(tests) == 0 {
+		return nil, nil
+	}
+
+	results, err := mc.query(ctx, fmt.Sprintf("(get-value (%s))", strings.Join(tests, " ")))
+	if err != nil {
+		return nil, err
+	}
+
+	values := util.SexprList(results)
+	if len(values) != len(tests) {
+		return nil, errors.New("malformed value from solver: " + results)
+	}
+
+	taken := make(map[*forks.Decision][]int)
+	i := 0
+	for _, d := range mc.decisions {
+		for j := range d.Tests {
+			parts := util.SexprList(values[i])
+			if len(parts) > 1 && parts[len(parts)-1] == "true" {
+				taken[d] = append(taken[d], j)
+			}
+			i++
+		}
+	}
+
+	var decided []*Decision
+	for _, d := range mc.decisions {
+		if len(taken[d]) == 0 || !optionTaken(d, taken) {
+			continue
+		}
+		decided = append(decided, decision(d, taken[d], mc.specRound(d.Round)))
+	}
+	sort.SliceStable(decided, func(i, j int) bool { return decided[i].Round < decided[j].Round })
+	return decided, nil
+}
+
+func optionTaken(d *forks.Decision, taken map[*forks.Decision][]int) bool {
+	// Conditionals in a parallel run count only when
+	// the run went the way of their option
+	for p, o := d.Parent, d.Option; p != nil; p, o = p.Parent, p.Option {
+		found := false
+		for _, t := range taken[p] {
+			if t == o {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func decision(d *forks.Decision, options []int, round int) *Decision {
+	r := &Decision{Round: round}
+	for _, o := range options {
+		if d.Parallel() {
+			r.Kind = "parallel"
+			var calls []string
+			for _, c := range d.Orders[o] {
+				calls = append(calls, util.SpecName(c))
+			}
+			r.Taken = append(r.Taken, strings.Join(calls, " before "))
+			continue
+		}
+
+		r.Kind = "if"
+		r.Taken = append(r.Taken, fmt.Sprintf("in %s, %s was %t", util.SpecName(d.Function), specExpr(d.Cond), o == 0))
+	}
+	return r
+}
+
+func specExpr(rule string) string {
+	// (> test1_t_foo_value_1 7.0) is (> t.foo.value 7.0)
+	return smt.SSARegex.ReplaceAllStringFunc(rule, func(id string) string {
+		return util.SpecName(id[:strings.LastIndex(id, "_")])
+	})
+}
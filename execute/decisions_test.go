@@ -0,0 +1,58 @@
+package execute
+
+import (
+	"context"
+	"fault/smt/forks"
+	"fault/smt/variables"
+	"testing"
+)
+
+func TestDecisions(t *testing.T) {
+	// t.baz ran first, so only its conditional counts
+	replies := map[string]string{
+		"check-sat":         "echo sat",
+		"get-model":         `echo "((define-fun x_0 () Real 10.0) (define-fun x_1 () Real 20.0) (define-fun x_2 () Real 18.0))"`,
+		"get-value ((= x_2": `echo "(((= x_2 x_3) false) ((= x_2 x_1) true) ((> x_0 7.0) true) ((not (> x_0 7.0)) false) ((> x_3 7.0) true) ((not (> x_3 7.0)) false))"`,
+		"get-value":         `echo "((x_0 10.0) (x_1 20.0) (x_2 18.0))"`,
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel("(declare-fun x_0 () Real)(declare-fun x_1 () Real)(declare-fun x_2 () Real)", make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+
+	run := &forks.Decision{
+		Orders: [][]string{{"@test1_t_bar", "@test1_t_baz"}, {"@test1_t_baz", "@test1_t_bar"}},
+		Tests:  []string{"(= x_2 x_3)", "(= x_2 x_1)"},
+	}
+	model.LoadMeta(nil, []*forks.Decision{
+		run,
+		{Function: "@test1_t_baz", Cond: "(> x_0 7.0)", Tests: []string{"(> x_0 7.0)", "(not (> x_0 7.0))"}, Parent: run, Option: 1},
+		{Function: "@test1_t_baz", Cond: "(> x_3 7.0)", Tests: []string{"(> x_3 7.0)", "(not (> x_3 7.0))"}, Parent: run, Option: 0},
+	})
+	defer model.Close()
+
+	found, err := model.Enumerate(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("enumerating scenarios failed. got=%s", err)
+	}
+
+	if len(found) != 1 {
+		t.Fatalf("wrong number of scenarios found. want=1 got=%d", len(found))
+	}
+
+	d := model.Decisions(model.Filter(found[0]))
+	if len(d) != 2 {
+		t.Fatalf("wrong number of decisions. want=2 got=%d", len(d))
+	}
+
+	if d[0].Kind != "parallel" || d[0].String() != "round 1: t.baz before t.bar" {
+		t.Fatalf("parallel decision is incorrect. got=%+v", d[0])
+	}
+
+	if d[1].Kind != "if" || d[1].String() != "round 1: in t.baz, (> x 7.0) was true" {
+		t.Fatalf("conditional decision is incorrect. got=%s", d[1].String())
+	}
+
+	report := model.Summary(found[0]).Scenarios[0]
+	if len(report.Decisions) != 2 {
+		t.Fatalf("decisions missing from report. got=%v", report.Decisions)
+	}
+}
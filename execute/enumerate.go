@@ -0,0 +1,190 @@
+package execute
+
+import (
+	"context"
+	"errors"
+	"fault/util"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Finds more than one way for the model to fail. After
+// each model the values that matter (the ones that survive
+// deadBranches) are blocked and the solver is asked again.
+// Scenarios below MinProbability are blocked without
+// being returned.
+
+const (
+	enumerateRetries = 3  // how many repeats per scenario before giving up
+	maxUnlikely      = 50 // how many scenarios below MinProbability before giving up
+)
+
+func (mc *ModelChecker) Enumerate(ctx context.Context, n int) ([]map[string]Scenario, error) {
+	var found []map[string]Scenario
+	seen := make(map[string]bool)
+
+	// Blocking clauses are scoped so the model can be
+	// checked again as written once we're done
+	err := mc.Push(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if mc.session != nil {
+			mc.Pop(ctx)
+		}
+	}()
+
+	var repeats, unlikely int
+	for len(found) < n && repeats < n*enumerateRetries && unlikely < maxUnlikely {
+		verdict, err := mc.CheckContext(ctx)
+		if err != nil {
+			return found, err
+		}
+
+		if verdict != SAT {
+			break
+		}
+
+		scenario, err := mc.SolveContext(ctx)
+		if err != nil {
+			return found, err
+		}
+
+		kept := mc.keptValues(scenario)
+		key := scenarioKey(kept)
+		switch {
+		case seen[key]:
+			repeats++
+		case !mc.Likely(scenario):
+			unlikely++
+		default:
+			seen[key] = true
+			found = append(found, scenario)
+
+			mc.violations[key], err = mc.violated(ctx)
+			if err != nil {
+				return found, err
+			}
+
+			mc.decided[key], err = mc.decide(ctx)
+			if err != nil {
+				return found, err
+			}
+		}
+
+		if len(found) == n {
+			break
+		}
+
+		clause, err := mc.blockingClause(ctx, kept)
+		if err != nil {
+			return found, err
+		}
+
+		if clause == "" { // Nothing left to vary
+			break
+		}
+
+		err = mc.Assert(ctx, clause)
+		if err != nil {
+			return found, err
+		}
+	}
+
+	mc.Unlikely = unlikely
+	if len(found) > 0 { // Later checks running out of models doesn't change the verdict
+		mc.Verdict = SAT
+		mc.Reason = ""
+	}
+	return found, nil
+}
+
+func (mc *ModelChecker) keptValues(scenario map[string]Scenario) map[string]Scenario {
+	kept := make(map[string]Scenario)
+	for k, v := range scenario {
+		kept[k] = deadBranches(k, copyScenario(v), mc.forks)
+	}
+	return kept
+}
+
+func (mc *ModelChecker) relevant() map[string]bool {
+	// SSA names the generator tracked, if it
+	// tracked any. Otherwise everything counts.
+	if len(mc.Results) == 0 {
+		return nil
+	}
+
+	r := make(map[string]bool)
+	for _, changes := range mc.Results {
+		for _, c := range changes {
+			r[c.Id] = true
+		}
+	}
+	return r
+}
+
+func (mc *ModelChecker) blockingClause(ctx context.Context, kept map[string]Scenario) (string, error) {
+	relevant := mc.relevant()
+
+	var names []string
+	for _, k := range sortedScenarioKeys(kept) {
+		for _, i := range traceIndexes(kept[k]) {
+			name := fmt.Sprintf("%s_%d", k, i)
+			if relevant == nil || relevant[name] {
+				names = append(names, name)
+			}
+		}
+	}
+
+	if len(names) == 0 {
+		return "", nil
+	}
+
+	// Values are fetched from the solver (rather than using
+	// the parsed floats) so the clause blocks the exact model
+	results, err := mc.query(ctx, fmt.Sprintf("(get-value (%s))", strings.Join(names, " ")))
+	if err != nil {
+		return "", err
+	}
+
+	var eqs []string
+	for _, pair := range util.SexprList(results) {
+		parts := util.SexprList(pair)
+		if len(parts) != 2 {
+			return "", errors.New("malformed value from solver: " + pair)
+		}
+		eqs = append(eqs, fmt.Sprintf("(= %s %s)", parts[0], mc.solver.NormalizeValue(parts[1])))
+	}
+
+	if len(eqs) == 1 {
+		return fmt.Sprintf("(assert (not %s))", eqs[0]), nil
+	}
+	return fmt.Sprintf("(assert (not (and %s)))", strings.Join(eqs, " ")), nil
+}
+
+func scenarioKey(kept map[string]Scenario) string {
+	var key strings.Builder
+	for _, k := range sortedScenarioKeys(kept) {
+		vr := variableReport(kept[k])
+		if vr == nil {
+			continue
+		}
+		key.WriteString(k)
+		for _, i := range traceIndexes(kept[k]) {
+			key.WriteString(fmt.Sprintf(" %d=%v", i, vr.Values[i]))
+		}
+		key.WriteString(";")
+	}
+	return key.String()
+}
+
+func sortedScenarioKeys(s map[string]Scenario) []string {
+	var keys []string
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
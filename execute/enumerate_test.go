@@ -0,0 +1,80 @@
+package execute
+
+import (
+	"context"
+	"fault/smt/variables"
+	"testing"
+)
+
+func TestEnumerate(t *testing.T) {
+	// A solver with exactly two models
+	replies := map[string]string{
+		"check-sat": "if [ ${n:-0} -lt 2 ]; then echo sat; else echo unsat; fi",
+		"get-model": `n=$((${n:-0}+1)); echo "((define-fun x_0 () Real $n.0))"`,
+		"get-value": `echo "((x_0 $n.0))"`,
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel("(declare-fun x_0 () Real)", make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	defer model.Close()
+
+	found, err := model.Enumerate(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("enumerating scenarios failed. got=%s", err)
+	}
+
+	if len(found) != 2 {
+		t.Fatalf("wrong number of scenarios found. want=2 got=%d", len(found))
+	}
+
+	if found[0]["x"].(*FloatTrace).results[0] != 1.0 || found[1]["x"].(*FloatTrace).results[0] != 2.0 {
+		t.Fatalf("scenarios found are incorrect. got=%v %v", found[0]["x"], found[1]["x"])
+	}
+
+	if model.Verdict != SAT {
+		t.Fatalf("enumeration changed the verdict. want=sat got=%s", model.Verdict)
+	}
+}
+
+func TestScenarioKey(t *testing.T) {
+	s1 := NewFloatTrace()
+	s1.Add(0, 2.0)
+	s1.Add(1, 3.0)
+	s2 := NewFloatTrace()
+	s2.Add(0, 2.0)
+	s2.Add(1, 3.0)
+	s3 := NewFloatTrace()
+	s3.Add(0, 2.0)
+	s3.Add(1, 4.0)
+
+	k1 := scenarioKey(map[string]Scenario{"x": s1})
+	k2 := scenarioKey(map[string]Scenario{"x": s2})
+	k3 := scenarioKey(map[string]Scenario{"x": s3})
+
+	if k1 != k2 {
+		t.Fatalf("identical scenarios have different keys. got=%s and %s", k1, k2)
+	}
+
+	if k1 == k3 {
+		t.Fatalf("different scenarios have the same key. got=%s", k1)
+	}
+}
+
+func TestKeptValues(t *testing.T) {
+	mc := NewModelCheckerWithSolver(NewZ3("", nil))
+	mc.forks["x"] = []*Branch{{trail: []int16{1}, phi: 3, base: "x"}, {trail: []int16{2}, phi: 3, base: "x"}}
+
+	s := NewFloatTrace()
+	s.Add(0, 1.0)
+	s.Add(1, 2.0)
+	s.Add(2, 5.0)
+	s.Add(3, 2.0)
+
+	kept := mc.keptValues(map[string]Scenario{"x": s})
+	if len(kept["x"].(*FloatTrace).results) != 2 {
+		t.Fatalf("dead branches not removed. got=%v", kept["x"].(*FloatTrace).results)
+	}
+
+	if len(s.results) != 4 {
+		t.Fatalf("original scenario was modified. got=%v", s.results)
+	}
+}
@@ -1,79 +1,84 @@
 package execute
 
 import (
-	"bytes"
+	"context"
 	"errors"
+	"fault/ast"
 	"fault/execute/parser"
 	"fault/smt/forks"
+	"fault/smt/properties"
 	"fault/smt/variables"
 	"fault/util"
 	"fmt"
-	"os"
-	"os/exec"
 	"strings"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
-	"gonum.org/v1/gonum/stat/distuv"
 )
 
-// Takes SMTLib2 and runs z3. If Uncertain types are present
-// execute will calculate the odds of z3 suggested state actually
+// Takes SMTLib2 and runs the solver. If Uncertain types are present
+// execute will calculate the odds of the suggested state actually
 // occurring and rerun the model.
 
-type Solver struct {
-	Command   string
-	Arguments []string
-}
-
 type ModelChecker struct {
-	SMT          string
-	Uncertains   map[string][]float64
-	Unknowns     []string
-	Results      map[string][]*variables.VarChange
-	ResultValues map[string]string
-	solver       map[string]*Solver
-	forks        map[string][]*Branch
+	SMT        string
+	Uncertains map[string][]float64
+	// Which distribution each uncertain value comes from,
+	// missing entries are assumed to be normal
+	Distributions map[string]*ast.Uncertain
+	Unknowns      []string
+	Ranges        map[string][]float64 // bounds on unknowns, min, max and step
+	Results       map[string][]*variables.VarChange
+	ResultValues  map[string]string
+	Properties    []*properties.Property // named asserts from the generator
+	File          string                 // the spec, for pointing at asserts
+	Positions     map[string][]int       // where variables are declared in the spec
+	VarRounds     map[string][][]int     // SSA index, round and step of every state, from the generator
+	RunRounds     []int                  // the round of the run block for each round in VarRounds
+	Components    [][]string             // each component of a system followed by its states
+	Core          []*CoreEntry           // why the model can't fail, see Explain
+	Warnings      []*Warning             // checks that pass for the wrong reason, see Sanity
+	solver        Solver
+	session       *session
+	Verdict       Verdict // result of the last check-sat
+	Reason        string  // the solver's :reason-unknown when Verdict is unknown
+	// Scenarios less likely than this are skipped
+	// and the model is rerun (0 keeps everything)
+	MinProbability float64
+	Unlikely       int // scenarios skipped by the last Enumerate
+	Depth          int // rounds in the shortest failure, see Incremental
+	sat            bool
+	forks          map[string][]*Branch
+	violations     map[string][]*Violation // by scenarioKey
+	decisions      []*forks.Decision
+	decided        map[string][]*Decision // by scenarioKey
 }
 
 func NewModelChecker() *ModelChecker {
+	return NewModelCheckerWithSolver(GenerateSolver())
+}
 
+func NewModelCheckerWithSolver(s Solver) *ModelChecker {
 	mc := &ModelChecker{
-		solver:       GenerateSolver(),
+		solver:       s,
 		forks:        make(map[string][]*Branch),
 		ResultValues: make(map[string]string),
+		violations:   make(map[string][]*Violation),
+		decided:      make(map[string][]*Decision),
 	}
 	return mc
 }
 
-func GenerateSolver() map[string]*Solver {
-	command, _ := os.LookupEnv("SOLVERCMD")
-	if command == "" {
-		panic("No solver is loaded, missing SOLVERCMD")
-	}
-
-	args, _ := os.LookupEnv("SOLVERARG")
-	if args == "" {
-		panic("No solver is loaded, missing SOLVERARG")
-	}
-
-	s := make(map[string]*Solver)
-	s["basic_run"] = &Solver{
-		Command:   command,
-		Arguments: []string{args},
-		/*Command: "z3",
-		Arguments: []string{"-in"}*/
-	}
-	return s
-}
-
 func (mc *ModelChecker) LoadModel(smt string, uncertains map[string][]float64, unknowns []string, results map[string][]*variables.VarChange) {
+	if mc.session != nil { // New model, new solver
+		mc.Close()
+	}
 	mc.SMT = smt
 	mc.Uncertains = uncertains
 	mc.Unknowns = unknowns
 	mc.Results = results
 }
 
-func (mc *ModelChecker) LoadMeta(frks []forks.Fork) {
+func (mc *ModelChecker) LoadMeta(frks []forks.Fork, decisions []*forks.Decision) {
 	// Load metadata that helps the results display nicely
 	tree := make(map[string][]*Branch)
 	for _, f := range frks {
@@ -92,48 +97,232 @@ func (mc *ModelChecker) LoadMeta(frks []forks.Fork) {
 		}
 	}
 	mc.forks = tree
+	mc.decisions = decisions
+}
+
+func (mc *ModelChecker) start(ctx context.Context) error {
+	// Starts the solver (if needed) and loads the model,
+	// a solver killed by an earlier query is restarted
+	// only while the context is still live
+	if mc.session != nil {
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if strings.Contains(mc.SMT, "(minimize") && !mc.solver.Optimizes() {
+		return fmt.Errorf("%s does not support minimize, optimizing needs z3", mc.solver.Name())
+	}
+
+	s, err := newSession(mc.solver)
+	if err != nil {
+		return err
+	}
+	mc.session = s
+
+	err = mc.session.send(append(mc.solver.Options(), mc.SMT)...)
+	if err != nil {
+		return err
+	}
+
+	err = mc.session.sync(ctx)
+	if ctx.Err() != nil { // the solver has been killed
+		mc.session = nil
+		return ctx.Err()
+	}
+	return err
+}
+
+func (mc *ModelChecker) Close() error {
+	if mc.session == nil {
+		return nil
+	}
+	err := mc.session.close()
+	mc.session = nil
+	mc.sat = false
+	return err
+}
+
+func (mc *ModelChecker) Push(ctx context.Context) error {
+	err := mc.start(ctx)
+	if err != nil {
+		return err
+	}
+	mc.sat = false
+	return mc.command(ctx, "(push 1)")
+}
+
+func (mc *ModelChecker) Pop(ctx context.Context) error {
+	if mc.session == nil { // The scope went with the solver
+		return nil
+	}
+	mc.sat = false
+	return mc.command(ctx, "(pop 1)")
 }
 
-func (mc *ModelChecker) run(command string, actions []string) (string, error) {
-	cmd := exec.Command(mc.solver[command].Command,
-		mc.solver[command].Arguments...)
+func (mc *ModelChecker) Assert(ctx context.Context, stmts ...string) error {
+	// Adds rules to the current scope of the solver
+	// (use Push/Pop to retract them later)
+	err := mc.start(ctx)
+	if err != nil {
+		return err
+	}
+	mc.sat = false
+	return mc.command(ctx, stmts...)
+}
 
-	cmd.Stdin = strings.NewReader(fmt.Sprint(mc.SMT, strings.Join(actions, "\n")))
+func (mc *ModelChecker) command(ctx context.Context, commands ...string) error {
+	err := mc.session.send(commands...)
+	if err != nil {
+		return err
+	}
 
-	var out bytes.Buffer
-	cmd.Stdout = &out
+	err = mc.session.sync(ctx)
+	if ctx.Err() != nil { // the solver has been killed
+		mc.session = nil
+		mc.sat = false
+		return ctx.Err()
+	}
+	return err
+}
 
-	err := cmd.Run()
+func (mc *ModelChecker) query(ctx context.Context, command string) (string, error) {
+	err := mc.start(ctx)
+	if err != nil {
+		return "", err
+	}
 
+	err = mc.session.send(command)
 	if err != nil {
 		return "", err
 	}
-	return strings.TrimSpace(out.String()), err
+
+	results, err := mc.session.readContext(ctx)
+	if ctx.Err() != nil { // the solver has been killed
+		mc.session = nil
+		mc.sat = false
+		return "", ctx.Err()
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if strings.HasPrefix(results, "(error") {
+		return "", errors.New(results)
+	}
+	return results, nil
 }
 
 func (mc *ModelChecker) Check() (bool, error) {
-	results, err := mc.run("basic_run", []string{"(check-sat)"})
+	verdict, err := mc.CheckContext(context.Background())
 	if err != nil {
 		return false, err
 	}
 
-	if util.FromEnd(results, 5) == "unsat" {
-		return false, nil
-	} else if util.FromEnd(results, 3) == "sat" {
-		return true, nil
-	} else {
-		return false, errors.New(results)
+	if verdict == UNKNOWN {
+		return false, fmt.Errorf("%s could not decide the model: %s", mc.solver.Name(), mc.Reason)
+	}
+	return verdict == SAT, nil
+}
+
+func (mc *ModelChecker) CheckContext(ctx context.Context) (Verdict, error) {
+	// Runs check-sat until the solver answers or the context
+	// is done. A cancelled check is treated as unknown.
+	mc.sat = false
+	mc.Verdict = ""
+	mc.Reason = ""
+
+	results, err := mc.query(ctx, "(check-sat)")
+	if err != nil && ctx.Err() != nil {
+		mc.Verdict = UNKNOWN
+		mc.Reason = contextReason(ctx.Err())
+		return mc.Verdict, nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	verdict, err := mc.solver.Verdict(results)
+	if err != nil {
+		return "", err
+	}
+
+	mc.Verdict = verdict
+	switch verdict {
+	case SAT:
+		mc.sat = true
+	case UNKNOWN:
+		mc.Reason = mc.reasonUnknown(ctx)
+	}
+	return verdict, nil
+}
+
+func (mc *ModelChecker) reasonUnknown(ctx context.Context) string {
+	// ie (:reason-unknown "timeout") or (:reason-unknown incomplete)
+	results, err := mc.query(ctx, "(get-info :reason-unknown)")
+	if err != nil {
+		return "reason unknown"
 	}
+	return parseReasonUnknown(results)
+}
+
+func parseReasonUnknown(results string) string {
+	reason := strings.TrimSpace(results)
+	reason = strings.TrimPrefix(reason, "(")
+	reason = strings.TrimSuffix(reason, ")")
+	reason = strings.TrimSpace(strings.TrimPrefix(reason, ":reason-unknown"))
+	reason = strings.Trim(reason, "\"")
+	if reason == "" {
+		return "reason unknown"
+	}
+	return reason
+}
+
+func contextReason(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "timeout"
+	}
+	return "canceled"
 }
 
 func (mc *ModelChecker) Solve() (map[string]Scenario, error) {
-	results, err := mc.run("basic_run", []string{"(check-sat)", "(get-model)"})
+	return mc.SolveContext(context.Background())
+}
+
+func (mc *ModelChecker) SolveContext(ctx context.Context) (map[string]Scenario, error) {
+	if !mc.sat { // (get-model) is only valid right after a sat result
+		verdict, err := mc.CheckContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch verdict {
+		case UNKNOWN:
+			return nil, fmt.Errorf("%s could not decide the model: %s", mc.solver.Name(), mc.Reason)
+		case UNSAT:
+			return nil, errors.New("no model available, the model is unsat")
+		}
+	}
+
+	decls := Declarations(mc.SMT)
+	q := mc.solver.ModelQuery(decls)
+	if q == "" { // Nothing declared, so no values to read
+		mc.ResultValues = make(map[string]string)
+		return make(map[string]Scenario), nil
+	}
+
+	results, err := mc.query(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	// Remove extra output (ie "sat")
-	results = cleanExtraOutputs(results)
+	results, err = mc.solver.NormalizeModel(results, decls)
+	if err != nil {
+		return nil, err
+	}
 
 	is := antlr.NewInputStream(results)
 	lexer := parser.NewSMTLIBv2Lexer(is)
@@ -149,20 +338,22 @@ func (mc *ModelChecker) Solve() (map[string]Scenario, error) {
 }
 
 func (mc *ModelChecker) Filter(results map[string]Scenario) map[string]Scenario {
-	for k, uncertain := range mc.Uncertains {
+	for k := range mc.Uncertains {
 		if results[k] != nil {
-			dist := distuv.Normal{
-				Mu:    uncertain[0],
-				Sigma: uncertain[1],
-			}
-
-			results[k] = mc.stateAssessment(dist, results[k])
+			results[k] = mc.stateAssessment(mc.distribution(k), results[k])
 		}
 	}
 	return results
 }
 
-func (mc *ModelChecker) stateAssessment(dist distuv.Normal, states Scenario) Scenario {
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (mc *ModelChecker) stateAssessment(dist Distribution, states Scenario) Scenario {
 	var weighted Scenario
 	switch s := states.(type) {
 	case *FloatTrace:
@@ -178,12 +369,12 @@ func (mc *ModelChecker) stateAssessment(dist distuv.Normal, states Scenario) Sce
 			weighted.(*IntTrace).AddWeight(i, dist.Prob(float64(state)))
 		}
 	case *BoolTrace:
-		//Requires Gaussian distr, TODO LATER
-		/*weighted = NewBoolTrace()
+		// Booleans are Bernoulli, true is a 1
+		weighted = NewBoolTrace()
 		weighted.(*BoolTrace).results = s.results
 		for i, state := range s.results {
-			weighted.(*BoolTrace).AddWeight(i, dist.Prob(state))
-		}*/
+			weighted.(*BoolTrace).AddWeight(i, dist.Prob(boolToFloat(state)))
+		}
 	}
 	return weighted
 }
@@ -1,8 +1,20 @@
 package execute
 
 import (
+	"context"
+	"errors"
+	"fault/listener"
+	"fault/llvm"
+	"fault/preprocess"
+	"fault/smt"
 	"fault/smt/variables"
+	"fault/types"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestSMTOk(t *testing.T) {
@@ -64,8 +76,238 @@ func TestProbability(t *testing.T) {
 
 }
 
+func TestMinimizeNeedsZ3(t *testing.T) {
+	model := NewModelCheckerWithSolver(NewCVC5("sh", []string{"-c", "cat"}))
+	model.LoadModel("(declare-fun x_0 () Real)(minimize x_0)", make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+
+	_, err := model.Check()
+	if err == nil || !strings.Contains(err.Error(), "does not support minimize") {
+		t.Fatalf("objective sent to a solver that can't optimize. got=%v", err)
+	}
+
+	if model.session != nil {
+		t.Fatal("solver started for a model it can't optimize")
+	}
+}
+
+func TestSMTScopes(t *testing.T) {
+	model := prepSolverTest(t, `(declare-fun x_0 () Real)(assert (> x_0 10.0))`)
+	defer model.Close()
+	ctx := context.Background()
+
+	if err := model.Push(ctx); err != nil {
+		t.Fatalf("SMT Solver failed to push a scope. got=%s", err)
+	}
+
+	if err := model.Assert(ctx, "(assert (< x_0 5.0))"); err != nil {
+		t.Fatalf("SMT Solver failed on valid assert. got=%s", err)
+	}
+
+	verdict, err := model.CheckContext(ctx)
+	if err != nil || verdict != UNSAT {
+		t.Fatalf("SMT Solver ignored the scoped assert. want=unsat got=%s %v", verdict, err)
+	}
+
+	if err := model.Pop(ctx); err != nil {
+		t.Fatalf("SMT Solver failed to pop a scope. got=%s", err)
+	}
+
+	solution, err := model.SolveContext(ctx)
+	if err != nil {
+		t.Fatalf("SMT Solver kept the popped assert. got=%s", err)
+	}
+
+	x, ok := solution["x"].(*FloatTrace)
+	if !ok || x.Get()[0] <= 10.0 {
+		t.Fatalf("SMT Solver solution not expected. got=%v", solution["x"])
+	}
+}
+
+func TestSMTMinimize(t *testing.T) {
+	model := prepSolverTest(t, `(declare-fun x_0 () Real)(assert (>= x_0 3.0))(minimize x_0)`)
+	defer model.Close()
+
+	if !model.solver.Optimizes() {
+		t.Skipf("%s does not support minimize", model.solver.Name())
+	}
+
+	solution, err := model.Solve()
+	if err != nil {
+		t.Fatalf("SMT Solver failed on objective. got=%s", err)
+	}
+
+	x, ok := solution["x"].(*FloatTrace)
+	if !ok || x.Get()[0] != 3.0 {
+		t.Fatalf("SMT Solver did not minimize. want=3.000000 got=%v", solution["x"])
+	}
+}
+
+func TestCheckTimeout(t *testing.T) {
+	// A solver that loads models but never answers check-sat
+	model := NewModelCheckerWithSolver(fakeSolver(nil))
+	model.LoadModel("(declare-fun imports_fl3_vault_value_0 () Real)", make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	verdict, err := model.CheckContext(ctx)
+	if err != nil {
+		t.Fatalf("SMT Solver timeout returned an error. got=%s", err)
+	}
+
+	if verdict != UNKNOWN {
+		t.Fatalf("SMT Solver timeout has the wrong verdict. want=unknown got=%s", verdict)
+	}
+
+	if model.Reason != "timeout" {
+		t.Fatalf("SMT Solver timeout has the wrong reason. want=timeout got=%s", model.Reason)
+	}
+
+	if model.session != nil {
+		t.Fatal("SMT Solver session not cleared after the solver was killed.")
+	}
+}
+
+func TestRestartTimeout(t *testing.T) {
+	// A solver that never finishes loading the model
+	hang := `while read l; do :; done`
+	model := NewModelCheckerWithSolver(NewZ3("sh", []string{"-c", hang}))
+	model.LoadModel("(declare-fun imports_fl3_vault_value_0 () Real)", make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	for i := 0; i < 2; i++ { // The second check runs after the context expired
+		start := time.Now()
+		verdict, err := model.CheckContext(ctx)
+		if err != nil {
+			t.Fatalf("check %d returned an error. got=%s", i, err)
+		}
+
+		if verdict != UNKNOWN || model.Reason != "timeout" {
+			t.Fatalf("check %d has the wrong verdict. want=unknown (timeout) got=%s (%s)", i, verdict, model.Reason)
+		}
+
+		if time.Since(start) > 2*time.Second {
+			t.Fatalf("check %d blocked loading the model past the timeout", i)
+		}
+
+		if model.session != nil {
+			t.Fatalf("SMT Solver session not cleared after check %d.", i)
+		}
+	}
+}
+
+func TestQueryTimeout(t *testing.T) {
+	// The solver never finishes a push and never sends the
+	// model it found, each is given up on with its context
+	model := NewModelCheckerWithSolver(fakeSolver(map[string]string{
+		"check-sat": "echo sat",
+		"push":      "exec sleep 10",
+	}))
+	model.LoadModel("(declare-fun imports_fl3_vault_value_0 () Real)", make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	defer model.Close()
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := model.Push(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("push not bound by the context. got=%v", err)
+	}
+
+	if model.session != nil {
+		t.Fatal("SMT Solver session not cleared after the solver was killed.")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := model.SolveContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("get-model not bound by the context. got=%v", err)
+	}
+
+	// Never finishes loading the model for the unsat core
+	hang := NewModelCheckerWithSolver(NewZ3("sh", []string{"-c", `while read l; do :; done`}))
+	hang.LoadModel("(declare-fun imports_fl3_vault_value_0 () Real)", make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := hang.Explain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unsat core not bound by the context. got=%v", err)
+	}
+
+	if time.Since(start) > 3*time.Second {
+		t.Fatal("solver blocked past the timeout")
+	}
+}
+
+func TestParseReasonUnknown(t *testing.T) {
+	tests := map[string]string{
+		`(:reason-unknown "timeout")`:  "timeout",
+		`(:reason-unknown incomplete)`: "incomplete",
+		`(:reason-unknown "")`:         "reason unknown",
+	}
+
+	for response, expected := range tests {
+		got := parseReasonUnknown(response)
+		if got != expected {
+			t.Fatalf("reason unknown parsed incorrectly. want=%s got=%s", expected, got)
+		}
+	}
+}
+
 func prepTest(smt string, uncertains map[string][]float64, unknowns []string, results map[string][]*variables.VarChange) *ModelChecker {
 	ex := NewModelChecker()
 	ex.LoadModel(smt, uncertains, unknowns, results)
 	return ex
 }
+
+func prepSolverTest(t *testing.T, smt string) *ModelChecker {
+	// Like prepTest, for tests that need a real solver's
+	// answers, skipped when SOLVERCMD isn't set
+	if os.Getenv("SOLVERCMD") == "" {
+		t.Skip("no solver loaded, set SOLVERCMD to run")
+	}
+	return prepTest(smt, make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+}
+
+func prepSpec(spec string, solver Solver) *ModelChecker {
+	// A checker set up the way main does from a spec
+	flags := map[string]bool{"specType": true, "testing": false, "skipRun": false}
+	l := listener.Execute(spec, "", flags)
+	pre := preprocess.Execute(l)
+	ty := types.Execute(pre.Processed, pre.Specs)
+	compiler := llvm.Execute(ty.Checked, ty.SpecStructs, l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, true)
+	generator := smt.Execute(compiler)
+	generator.PerProperty = true
+
+	mc := NewModelCheckerWithSolver(solver)
+	mc.LoadModel(generator.SMT(), l.Uncertains, l.Unknowns, generator.Results)
+	mc.Properties = generator.Properties
+	mc.VarRounds = generator.RVarLookup
+	mc.RunRounds = generator.RunRounds
+	return mc
+}
+
+func fakeSolver(replies map[string]string) *Z3 {
+	// A Z3 that answers from replies instead of solving. Each
+	// key is looked for in every command sent, longest keys
+	// first, and its reply is run as shell, so a reply can
+	// echo an answer or keep state for later ones (w=1, $w).
+	// fault-sync is always echoed so the session can sync.
+	var keys []string
+	for k := range replies {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	script := "while read l; do case \"$l\" in\n*fault-sync*) echo fault-sync;;\n"
+	for _, k := range keys {
+		script += fmt.Sprintf("*'%s'*) %s;;\n", k, replies[k])
+	}
+	script += "esac; done"
+	return NewZ3("sh", []string{"-c", script})
+}
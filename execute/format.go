@@ -2,9 +2,14 @@ package execute
 
 import (
 	"bytes"
+	"encoding/json"
 	"fault/smt/variables"
 	"fmt"
+	"math"
+	"os"
+	"sort"
 	"strings"
+	"text/tabwriter"
 )
 
 /*
@@ -49,9 +54,50 @@ func (mc *ModelChecker) writeObject(o *variables.VarChange) string {
 
 func (mc *ModelChecker) Format(results map[string]Scenario) {
 	var out bytes.Buffer
+	if lp, ok := mc.LogProbability(results); ok {
+		out.WriteString(fmt.Sprintf("joint log-probability: %f\n\n", lp))
+	}
+	if v := mc.Violations(results); len(v) > 0 {
+		for _, a := range v {
+			out.WriteString(a.String(mc.File) + "\n")
+		}
+		out.WriteString("\n")
+	}
+	if d := mc.Decisions(results); len(d) > 0 {
+		out.WriteString("decisions\n")
+		for _, a := range d {
+			out.WriteString(a.String() + "\n")
+		}
+		out.WriteString("\n")
+	}
+	if len(mc.VarRounds) > 0 && len(mc.Components) > 0 { // A system, states go on the timeline
+		mc.Timeline(&out, results)
+		rest := make(map[string]Scenario)
+		for k, v := range results {
+			if !mc.isState(k) {
+				rest[k] = v
+			}
+		}
+		if len(rest) > 0 {
+			out.WriteString("\n")
+			mc.Table(&out, rest)
+		}
+		fmt.Println(out.String())
+		return
+	}
+	if len(mc.VarRounds) > 0 { // Compiled from a spec, so rounds are known
+		mc.Table(&out, results)
+		fmt.Println(out.String())
+		return
+	}
+
 	//results = definePath(results, mc.forks)
 	for k, v := range results {
-		out.WriteString(k + "\n")
+		if r, ok := mc.Ranges[k]; ok {
+			out.WriteString(fmt.Sprintf("%s %s\n", k, formatRange(r)))
+		} else {
+			out.WriteString(k + "\n")
+		}
 		filtered := deadBranches(k, v, mc.forks)
 		r := generateRows(filtered)
 		out.WriteString(strings.Join(r, " ") + "\n\n")
@@ -59,6 +105,161 @@ func (mc *ModelChecker) Format(results map[string]Scenario) {
 	fmt.Println(out.String())
 }
 
+// One scenario of a Summary, or the one
+// behind a property's result
+type Report struct {
+	Verdict string `json:"verdict"`
+	// Joint log-probability of the uncertain values,
+	// missing if there are none (or it's -Inf)
+	LogProbability *float64                   `json:"log_probability,omitempty"`
+	Violations     []*Violation               `json:"violations,omitempty"` // asserts the scenario breaks
+	Decisions      []*Decision                `json:"decisions,omitempty"`  // parallel orders and branches taken
+	Depth          int                        `json:"depth,omitempty"`      // rounds in the shortest failure
+	Variables      map[string]*VariableReport `json:"variables,omitempty"`
+}
+
+type VariableReport struct {
+	Type         string                `json:"type"`
+	Values       map[int16]interface{} `json:"values"`
+	Weights      map[int16]float64     `json:"weights,omitempty"`
+	Uncertain    []float64             `json:"uncertain,omitempty"`    // mean and sigma
+	Distribution string                `json:"distribution,omitempty"` // ie normal, uniform
+	Range        []float64             `json:"range,omitempty"`        // min, max and step of an unknown
+	Removed      []int16               `json:"removed,omitempty"`      // SSA indexes dropped by deadBranches
+}
+
+// Verdict of a run where every failure found was
+// less likely than -min-probability
+const UNLIKELY = "unlikely"
+
+// Everything a run found, the same shape whether
+// there are no scenarios, one or several
+type Summary struct {
+	Verdict   string       `json:"verdict"`
+	Reason    string       `json:"reason,omitempty"`   // why the solver returned unknown
+	Unlikely  int          `json:"unlikely,omitempty"` // failures skipped by -min-probability
+	Core      []*CoreEntry `json:"core,omitempty"`     // why there's no failure
+	Warnings  []*Warning   `json:"warnings,omitempty"` // checks that pass for the wrong reason
+	Scenarios []*Report    `json:"scenarios"`
+}
+
+func (mc *ModelChecker) Summary(results ...map[string]Scenario) *Summary {
+	s := &Summary{Warnings: mc.Warnings, Unlikely: mc.Unlikely, Scenarios: []*Report{}}
+	switch {
+	case mc.Verdict == UNKNOWN:
+		s.Verdict, s.Reason = string(UNKNOWN), mc.Reason
+	case len(results) == 0 && mc.Unlikely > 0:
+		s.Verdict = UNLIKELY
+	case len(results) == 0:
+		s.Verdict, s.Core = string(UNSAT), mc.Core
+	default:
+		s.Verdict = string(SAT)
+	}
+
+	for _, r := range results {
+		s.Scenarios = append(s.Scenarios, mc.scenarioReport(r))
+	}
+	return s
+}
+
+func (mc *ModelChecker) JSON(results ...map[string]Scenario) error {
+	out, err := json.MarshalIndent(mc.Summary(results...), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+func (mc *ModelChecker) scenarioReport(results map[string]Scenario) *Report {
+	r := &Report{
+		Verdict:   string(SAT),
+		Variables: make(map[string]*VariableReport),
+	}
+	if lp, ok := mc.LogProbability(results); ok && !math.IsInf(lp, 0) && !math.IsNaN(lp) {
+		r.LogProbability = &lp
+	}
+	r.Violations = mc.Violations(results)
+	r.Decisions = mc.Decisions(results)
+	r.Depth = mc.Depth
+	for k, v := range results {
+		before := traceIndexes(v)
+		filtered := deadBranches(k, v, mc.forks)
+		vr := variableReport(filtered)
+		if vr == nil {
+			continue
+		}
+
+		for _, i := range before {
+			if _, ok := vr.Values[i]; !ok {
+				vr.Removed = append(vr.Removed, i)
+			}
+		}
+		vr.Uncertain = mc.Uncertains[k]
+		if u, ok := mc.Distributions[k]; ok {
+			vr.Distribution = u.Distribution
+		}
+		vr.Range = mc.Ranges[k]
+		r.Variables[k] = vr
+	}
+	return r
+}
+
+func formatRange(r []float64) string {
+	// The bounds an unknown value was chosen from
+	if len(r) == 3 {
+		return fmt.Sprintf("in [%g, %g] step %g", r[0], r[1], r[2])
+	}
+	return fmt.Sprintf("in [%g, %g]", r[0], r[1])
+}
+
+func variableReport(v Scenario) *VariableReport {
+	vr := &VariableReport{Values: make(map[int16]interface{})}
+	switch s := v.(type) {
+	case *FloatTrace:
+		vr.Type = "float"
+		for i, n := range s.Get() {
+			vr.Values[i] = n
+		}
+		vr.Weights = s.GetWeights()
+	case *IntTrace:
+		vr.Type = "int"
+		for i, n := range s.Get() {
+			vr.Values[i] = n
+		}
+		vr.Weights = s.GetWeights()
+	case *BoolTrace:
+		vr.Type = "bool"
+		for i, n := range s.Get() {
+			vr.Values[i] = n
+		}
+		vr.Weights = s.GetWeights()
+	default:
+		return nil
+	}
+	return vr
+}
+
+func traceIndexes(v Scenario) []int16 {
+	var idx []int16
+	switch s := v.(type) {
+	case *FloatTrace:
+		for i := range s.Get() {
+			idx = append(idx, i)
+		}
+	case *IntTrace:
+		for i := range s.Get() {
+			idx = append(idx, i)
+		}
+	case *BoolTrace:
+		for i := range s.Get() {
+			idx = append(idx, i)
+		}
+	}
+	sort.Slice(idx, func(i, j int) bool { return idx[i] < idx[j] })
+	return idx
+}
+
 func generateRows(v Scenario) []string {
 	switch s := v.(type) {
 	case *FloatTrace:
@@ -231,3 +432,79 @@ func hWToString(n interface{}, i int16, weights map[int16]float64) string {
 		panic(fmt.Sprintf("type %T not allowed", n))
 	}
 }
+
+type PropertyReport struct {
+	Name     string  `json:"name"`
+	Label    string  `json:"label,omitempty"`
+	Line     int     `json:"line"`
+	Column   int     `json:"column"`
+	Status   string  `json:"status"`           // holds, violated, unknown, proved or not proved
+	Reason   string  `json:"reason,omitempty"` // why the solver returned unknown
+	Round    int     `json:"round,omitempty"`
+	K        int     `json:"k,omitempty"` // induction depth
+	Scenario *Report `json:"scenario,omitempty"`
+}
+
+func (mc *ModelChecker) PropertiesJSON(results []*PropertyResult) error {
+	var reports []*PropertyReport
+	for _, r := range results {
+		pr := &PropertyReport{
+			Name:   r.Property.Name,
+			Label:  r.Property.Label,
+			Line:   r.Property.Line(),
+			Status: r.Status,
+			Reason: r.Reason,
+			Round:  r.Round,
+			K:      r.K,
+		}
+		if len(r.Property.Position) > 1 {
+			pr.Column = r.Property.Position[1]
+		}
+		if r.Scenario != nil {
+			pr.Scenario = mc.scenarioReport(r.Scenario)
+		}
+		reports = append(reports, pr)
+	}
+
+	out, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+func (mc *ModelChecker) FormatProperties(results []*PropertyResult) {
+	// One line per property, then the scenario
+	// behind each violation
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "PROPERTY\tSTATUS")
+	for _, r := range results {
+		fmt.Fprintf(w, "%s\t%s\n", propertyName(mc.File, r.Property), propertyStatus(r))
+	}
+	w.Flush()
+	fmt.Println()
+
+	for _, r := range results {
+		if r.Scenario == nil {
+			continue
+		}
+		fmt.Printf("~~~~~~~~~~\n  %s\n~~~~~~~~~~\n", propertyName(mc.File, r.Property))
+		mc.Format(r.Scenario)
+	}
+}
+
+func propertyStatus(r *PropertyResult) string {
+	switch {
+	case r.Status == VIOLATED && r.Round > 0:
+		return fmt.Sprintf("%s in round %d", r.Status, r.Round)
+	case r.Status == PROVED:
+		return fmt.Sprintf("proved for all rounds at k=%d", r.K)
+	case r.Status == UNPROVED && r.Reason == "" && r.Scenario != nil:
+		return fmt.Sprintf("%s, inductive counterexample at k=%d", r.Status, r.K)
+	case (r.Status == string(UNKNOWN) || r.Status == UNPROVED) && r.Reason != "":
+		return fmt.Sprintf("%s (%s)", r.Status, r.Reason)
+	default:
+		return r.Status
+	}
+}
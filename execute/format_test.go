@@ -1,6 +1,8 @@
 package execute
 
 import (
+	"bytes"
+	"encoding/json"
 	"fault/smt/forks"
 	"testing"
 )
@@ -39,7 +41,7 @@ func TestPhis(t *testing.T) {
 		}}}
 
 	mc := NewModelChecker()
-	mc.LoadMeta(phis)
+	mc.LoadMeta(phis, nil)
 
 	v := deadBranches("test_value", test["test_value"], mc.forks)
 	if _, ok := v.(*FloatTrace).Index(3); ok {
@@ -97,7 +99,7 @@ func TestMultiPhis(t *testing.T) {
 	}
 
 	mc := NewModelChecker()
-	mc.LoadMeta(phis)
+	mc.LoadMeta(phis, nil)
 
 	v := deadBranches("test_value", test["test_value"], mc.forks)
 	if _, ok := v.(*FloatTrace).Index(3); ok {
@@ -122,3 +124,255 @@ func TestMultiPhis(t *testing.T) {
 		t.Fatal("phi at index 9 not was removed")
 	}
 }
+
+func TestReport(t *testing.T) {
+	test := make(map[string]Scenario)
+	test["test_value"] = &FloatTrace{
+		results: map[int16]float64{0: 1.0, 1: 2.3, 3: 4.0, 4: 2.3},
+		weights: map[int16]float64{0: 0.5},
+	}
+
+	phis := []forks.Fork{{
+		"test_value": []*forks.Choice{
+			{
+				Base:   "test_value",
+				Values: []int16{1},
+			},
+			{
+				Base:   "test_value",
+				Values: []int16{3},
+			},
+		}}}
+
+	mc := NewModelChecker()
+	mc.LoadMeta(phis, nil)
+	mc.Uncertains = map[string][]float64{"test_value": {1.0, 0.5}}
+
+	mc.Verdict = SAT
+	summary := mc.Summary(test)
+	if summary.Verdict != "sat" || len(summary.Scenarios) != 1 {
+		t.Fatalf("summary is wrong. want=sat with one scenario got=%+v", summary)
+	}
+
+	report := summary.Scenarios[0]
+
+	v, ok := report.Variables["test_value"]
+	if !ok {
+		t.Fatal("variable test_value missing from report")
+	}
+
+	if v.Type != "float" {
+		t.Fatalf("variable test_value has the wrong type. want=float got=%s", v.Type)
+	}
+
+	if len(v.Values) != 2 || v.Values[0] != 1.0 || v.Values[1] != 2.3 {
+		t.Fatalf("variable test_value has the wrong values. got=%v", v.Values)
+	}
+
+	if len(v.Removed) != 2 || v.Removed[0] != 3 || v.Removed[1] != 4 {
+		t.Fatalf("variable test_value removed indexes incorrect. got=%v", v.Removed)
+	}
+
+	if v.Weights[0] != 0.5 {
+		t.Fatalf("variable test_value weights incorrect. got=%v", v.Weights)
+	}
+
+	if len(v.Uncertain) != 2 {
+		t.Fatalf("variable test_value uncertain parameters missing. got=%v", v.Uncertain)
+	}
+
+	mc.Verdict = UNSAT
+	unsat := mc.Summary()
+	if unsat.Verdict != "unsat" || len(unsat.Scenarios) != 0 {
+		t.Fatalf("unsat summary malformed. got=%+v", unsat)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	test := map[string]Scenario{
+		"test_value": &FloatTrace{
+			results: map[int16]float64{0: 1.0},
+			weights: map[int16]float64{},
+		},
+	}
+
+	mc := NewModelChecker()
+	mc.Verdict = SAT
+	for _, n := range []int{1, 2} {
+		var results []map[string]Scenario
+		for i := 0; i < n; i++ {
+			results = append(results, test)
+		}
+
+		out, err := json.Marshal(mc.Summary(results...))
+		if err != nil {
+			t.Fatalf("summary of %d scenarios could not be marshaled. got=%s", n, err)
+		}
+
+		var got struct {
+			Verdict   string            `json:"verdict"`
+			Scenarios []json.RawMessage `json:"scenarios"`
+		}
+		if err := json.Unmarshal(out, &got); err != nil {
+			t.Fatalf("summary of %d scenarios is not an object. got=%s", n, out)
+		}
+
+		if got.Verdict != "sat" || len(got.Scenarios) != n {
+			t.Fatalf("summary of %d scenarios is incorrect. got=%s", n, out)
+		}
+	}
+
+	mc.Verdict = UNSAT
+	out, _ := json.Marshal(mc.Summary())
+	if string(out) != `{"verdict":"unsat","scenarios":[]}` {
+		t.Fatalf("summary without scenarios is incorrect. got=%s", out)
+	}
+}
+
+func TestReportRange(t *testing.T) {
+	test := make(map[string]Scenario)
+	test["test_level"] = &FloatTrace{
+		results: map[int16]float64{0: 3.0},
+	}
+
+	mc := NewModelCheckerWithSolver(NewZ3("", nil))
+	mc.Ranges = map[string][]float64{"test_level": {1, 5, 2}}
+	mc.Verdict = SAT
+
+	report := mc.Summary(test).Scenarios[0]
+	v, ok := report.Variables["test_level"]
+	if !ok {
+		t.Fatal("variable test_level missing from report")
+	}
+
+	if len(v.Range) != 3 || v.Range[0] != 1 || v.Range[1] != 5 {
+		t.Fatalf("variable test_level range incorrect. got=%v", v.Range)
+	}
+
+	if formatRange(v.Range) != "in [1, 5] step 2" {
+		t.Fatalf("range formatted incorrectly. got=%s", formatRange(v.Range))
+	}
+
+	if formatRange([]float64{0, 10.5}) != "in [0, 10.5]" {
+		t.Fatalf("range formatted incorrectly. got=%s", formatRange([]float64{0, 10.5}))
+	}
+}
+
+func TestTable(t *testing.T) {
+	// The const is set up before the run block, so the
+	// generator's rounds are one ahead of the spec's
+	spec := `spec test1;
+	const drain = 2;
+
+	def tub = stock{
+		level: 10,
+	};
+
+	def pipe = flow{
+		t: new tub,
+		out: func{
+			t.level -> drain;
+		},
+	};
+
+	for 2 run {
+		p = new pipe;
+		p.out;
+	};
+	`
+	mc := prepSpec(spec, nil)
+
+	test := make(map[string]Scenario)
+	test["test1_drain"] = &FloatTrace{
+		results: map[int16]float64{0: 2.0},
+		weights: map[int16]float64{},
+	}
+	test["test1_p_t_level"] = &FloatTrace{
+		results: map[int16]float64{0: 10.0, 1: 8.0, 2: 6.0, 5: 1.0}, // 5 isn't a state of the model
+		weights: map[int16]float64{},
+	}
+
+	var out bytes.Buffer
+	mc.Table(&out, test)
+
+	// Round 1 also has the initial value
+	want := `+-----------+---+-----+---+---+
+| VARIABLE  | 0 | 1.1 | 1 | 2 |
++-----------+---+-----+---+---+
+| drain     | 2 |     | 2 | 2 |
+| p.t.level |   |  10 | 8 | 6 |
++-----------+---+-----+---+---+
+`
+	if out.String() != want {
+		t.Fatalf("table is incorrect.\nwant=\n%s\ngot=\n%s", want, out.String())
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	test := make(map[string]Scenario)
+	test["test1_a_foo"] = &BoolTrace{
+		results: map[int16]bool{0: false, 1: true, 2: false},
+		weights: map[int16]float64{},
+	}
+	test["test1_a_zoo"] = &BoolTrace{
+		results: map[int16]bool{0: true, 1: false, 2: true},
+		weights: map[int16]float64{},
+	}
+	test["test1_b_buzz"] = &BoolTrace{
+		results: map[int16]bool{0: true, 1: false},
+		weights: map[int16]float64{},
+	}
+	test["test1_b_bar"] = &BoolTrace{
+		results: map[int16]bool{0: false, 1: true},
+		weights: map[int16]float64{},
+	}
+
+	mc := NewModelChecker()
+	mc.VarRounds = map[string][][]int{
+		"test1_a_foo":  {{0, 0, 0}, {1, 1, 0}, {2, 2, 0}},
+		"test1_a_zoo":  {{0, 0, 1}, {1, 1, 1}, {2, 2, 1}},
+		"test1_b_buzz": {{0, 0, 2}, {1, 2, 0}},
+		"test1_b_bar":  {{0, 0, 3}, {1, 2, 1}},
+	}
+	mc.Components = [][]string{
+		{"test1_a", "test1_a_foo", "test1_a_zoo"},
+		{"test1_b", "test1_b_buzz", "test1_b_bar"},
+	}
+
+	var out bytes.Buffer
+	mc.Timeline(&out, test)
+
+	// b stays in buzz until round 3
+	want := `+-----------+------+------+-----+
+| COMPONENT |  1   |  2   |  3  |
++-----------+------+------+-----+
+| a         | zoo  | foo  | zoo |
+| b         | buzz | buzz | bar |
++-----------+------+------+-----+
+
+transitions
+round 2: a.zoo -> a.foo
+round 3: a.foo -> a.zoo
+round 3: b.buzz -> b.bar
+`
+	if out.String() != want {
+		t.Fatalf("timeline is incorrect.\nwant=\n%s\ngot=\n%s", want, out.String())
+	}
+
+	chart := mc.stateChart(test)
+	wantChart := "gantt\n\tdateFormat X\n\taxisFormat %s\n" +
+		"\tsection a\n\tzoo :1, 2\n\tfoo :2, 3\n\tzoo :3, 4\n" +
+		"\tzoo -> foo :milestone, 2, 2\n\tfoo -> zoo :milestone, 3, 3\n" +
+		"\tsection b\n\tbuzz :1, 3\n\tbar :3, 4\n" +
+		"\tbuzz -> bar :milestone, 3, 3\n"
+	if chart != wantChart {
+		t.Fatalf("state chart is incorrect.\nwant=%q\ngot=%q", wantChart, chart)
+	}
+
+	// With a start block the first round is the setup
+	mc.RunRounds = []int{0, 1, 2}
+	changes := mc.timeline(test).changes()
+	if len(changes) != 3 || changes[0].String() != "round 1: a.zoo -> a.foo" || changes[2].String() != "round 2: b.buzz -> b.bar" {
+		t.Fatalf("transitions are in the wrong rounds. got=%v", changes)
+	}
+}
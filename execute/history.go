@@ -137,3 +137,38 @@ func (bt *BoolTrace) Remove(i int16) {
 	delete(bt.results, i)
 	delete(bt.weights, i)
 }
+
+func copyScenario(s Scenario) Scenario {
+	// deadBranches edits traces in place, copy
+	// first if the original is still needed
+	switch v := s.(type) {
+	case *FloatTrace:
+		c := NewFloatTrace()
+		for i, r := range v.results {
+			c.results[i] = r
+		}
+		for i, w := range v.weights {
+			c.weights[i] = w
+		}
+		return c
+	case *IntTrace:
+		c := NewIntTrace()
+		for i, r := range v.results {
+			c.results[i] = r
+		}
+		for i, w := range v.weights {
+			c.weights[i] = w
+		}
+		return c
+	case *BoolTrace:
+		c := NewBoolTrace()
+		for i, r := range v.results {
+			c.results[i] = r
+		}
+		for i, w := range v.weights {
+			c.weights[i] = w
+		}
+		return c
+	}
+	return s
+}
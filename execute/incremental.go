@@ -0,0 +1,134 @@
+package execute
+
+import "context"
+
+// Finds the shortest failure. The model is unrolled for
+// every round of the run block, but only the parts of the
+// asserts that fall in the first d rounds are checked, with
+// d going up one round at a time in the same solver. The
+// first d that fails is the minimal depth of the failure.
+
+func (mc *ModelChecker) Incremental(ctx context.Context) ([]map[string]Scenario, error) {
+	mc.Depth = 0
+	rounds := mc.assertRounds()
+	for d := 1; d <= rounds; d++ {
+		clauses := mc.clausesUpTo(d, d == rounds)
+		if len(clauses) == 0 {
+			continue
+		}
+
+		scenario, err := mc.checkDepth(ctx, clauses)
+		if err != nil {
+			return nil, err
+		}
+
+		if mc.Verdict == UNKNOWN {
+			return nil, nil
+		}
+
+		if scenario != nil {
+			mc.Depth = mc.depth(d)
+			return []map[string]Scenario{scenario}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (mc *ModelChecker) Rounds() int {
+	// How many rounds of the run block the asserts cover
+	return mc.depth(mc.assertRounds())
+}
+
+func (mc *ModelChecker) depth(d int) int {
+	// The first d of the generator's rounds as rounds of
+	// the run block. A failure in the setup before the run
+	// block still takes its first round.
+	if d == 0 {
+		return 0
+	}
+	if r := mc.specRound(d - 1); r > 0 {
+		return r
+	}
+	return 1
+}
+
+func (mc *ModelChecker) assertRounds() int {
+	// How many of the generator's rounds the asserts cover
+	var rounds int
+	for _, p := range mc.Properties {
+		if p.Assume {
+			continue
+		}
+		for _, r := range p.Rounds {
+			if r+1 > rounds {
+				rounds = r + 1
+			}
+		}
+	}
+	if rounds == 0 && len(mc.clausesUpTo(1, true)) > 0 {
+		rounds = 1
+	}
+	return rounds
+}
+
+func (mc *ModelChecker) clausesUpTo(depth int, last bool) []string {
+	var clauses []string
+	for _, p := range mc.Properties {
+		if p.Assume {
+			continue
+		}
+
+		if len(p.Clauses) == 0 { // Can't split it, so it's checked at full depth
+			if last {
+				clauses = append(clauses, p.Rule)
+			}
+			continue
+		}
+
+		for i, c := range p.Clauses {
+			if p.Round(i) < depth {
+				clauses = append(clauses, c)
+			}
+		}
+	}
+	return clauses
+}
+
+func (mc *ModelChecker) checkDepth(ctx context.Context, clauses []string) (map[string]Scenario, error) {
+	err := mc.Push(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if mc.session != nil {
+			mc.Pop(ctx)
+		}
+	}()
+
+	err = mc.Assert(ctx, "(assert "+disjunction(clauses)+")")
+	if err != nil {
+		return nil, err
+	}
+
+	verdict, err := mc.CheckContext(ctx)
+	if err != nil || verdict != SAT {
+		return nil, err
+	}
+
+	scenario, err := mc.SolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key := scenarioKey(mc.keptValues(scenario))
+	mc.violations[key], err = mc.violated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mc.decided[key], err = mc.decide(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return scenario, nil
+}
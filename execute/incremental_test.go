@@ -0,0 +1,133 @@
+package execute
+
+import (
+	"context"
+	"fault/smt/properties"
+	"fault/smt/variables"
+	"testing"
+)
+
+func TestIncremental(t *testing.T) {
+	// Fails once round 2 is checked
+	replies := map[string]string{
+		"pop":                               "w=0",
+		"get-value":                         `echo "(((<= x_0 0) false) ((<= x_1 0) true) ((<= x_2 0) false))"`,
+		"(assert (or (<= x_0 0) (<= x_1 0)": "w=1",
+		"check-sat":                         `if [ "$w" = "1" ]; then echo sat; else echo unsat; fi`,
+		"get-model":                         `echo "((define-fun x_0 () Real 10.0) (define-fun x_1 () Real 0.0))"`,
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel("(declare-fun x_0 () Real)(declare-fun x_1 () Real)(declare-fun x_2 () Real)", make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	model.Properties = []*properties.Property{
+		{
+			Name:     "assert_0",
+			Position: []int{22, 1},
+			Rule:     "(or (<= x_0 0) (<= x_1 0) (<= x_2 0))",
+			Clauses:  []string{"(<= x_0 0)", "(<= x_1 0)", "(<= x_2 0)"},
+			Rounds:   []int{0, 1, 2},
+		},
+		{
+			Name:     "assume_0",
+			Position: []int{23, 1},
+			Assume:   true,
+			Rule:     "(> x_2 5)",
+			Clauses:  []string{"(> x_2 5)"},
+			Rounds:   []int{4},
+		},
+	}
+	defer model.Close()
+
+	if model.Rounds() != 3 {
+		t.Fatalf("wrong number of rounds, assumes don't count. want=3 got=%d", model.Rounds())
+	}
+
+	found, err := model.Incremental(context.Background())
+	if err != nil {
+		t.Fatalf("incremental check failed. got=%s", err)
+	}
+
+	if len(found) != 1 || model.Depth != 2 || model.Verdict != SAT {
+		t.Fatalf("shortest failure not found. depth=%d verdict=%s found=%d", model.Depth, model.Verdict, len(found))
+	}
+
+	report := model.Summary(found[0]).Scenarios[0]
+	if report.Depth != 2 || len(report.Violations) != 1 || report.Violations[0].Round != 2 {
+		t.Fatalf("report is incorrect. got=%+v", report)
+	}
+}
+
+func TestIncrementalHolds(t *testing.T) {
+	replies := map[string]string{
+		"check-sat": "echo unsat",
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel("(declare-fun x_0 () Real)(declare-fun x_1 () Real)", make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	model.Properties = []*properties.Property{
+		{
+			Name:    "assert_0",
+			Rule:    "(or (<= x_0 0) (<= x_1 0))",
+			Clauses: []string{"(<= x_0 0)", "(<= x_1 0)"},
+			Rounds:  []int{0, 1},
+		},
+	}
+	defer model.Close()
+
+	found, err := model.Incremental(context.Background())
+	if err != nil {
+		t.Fatalf("incremental check failed. got=%s", err)
+	}
+
+	if len(found) != 0 || model.Depth != 0 || model.Verdict != UNSAT {
+		t.Fatalf("model should hold at every depth. depth=%d verdict=%s", model.Depth, model.Verdict)
+	}
+}
+
+func TestIncrementalConstants(t *testing.T) {
+	// The constant is set before the run block, the
+	// value drops below 7 in the second round
+	test := `spec test1;
+
+	const a = 2;
+
+	def amount = stock{
+		value: 10,
+	};
+
+	def test = flow{
+		foo: new amount,
+		bar: func{
+			foo.value -> a;
+		},
+	};
+
+	assert amount.value > 7;
+
+	for 2 run {
+		t = new test;
+		t.bar;
+	};
+	`
+	replies := map[string]string{
+		"pop":                                    "w=0",
+		"(assert (or (<= test1_t_foo_value_0 7)": `case "$l" in *value_2*) w=1;; esac`,
+		"check-sat":                              `if [ "$w" = "1" ]; then echo sat; else echo unsat; fi`,
+		"get-model":                              `echo "((define-fun test1_a_0 () Real 2.0) (define-fun test1_t_foo_value_0 () Real 10.0) (define-fun test1_t_foo_value_1 () Real 8.0) (define-fun test1_t_foo_value_2 () Real 6.0))"`,
+		"get-value ((<=":                         `echo "(((<= test1_t_foo_value_0 7) false) ((<= test1_t_foo_value_1 7) false) ((<= test1_t_foo_value_2 7) true))"`,
+		"get-value":                              `echo "((test1_a_0 2.0) (test1_t_foo_value_0 10.0) (test1_t_foo_value_1 8.0) (test1_t_foo_value_2 6.0))"`,
+	}
+	model := prepSpec(test, fakeSolver(replies))
+	defer model.Close()
+
+	if model.Rounds() != 2 {
+		t.Fatalf("wrong number of rounds, the setup isn't one. want=2 got=%d", model.Rounds())
+	}
+
+	found, err := model.Incremental(context.Background())
+	if err != nil {
+		t.Fatalf("incremental check failed. got=%s", err)
+	}
+
+	if len(found) != 1 || model.Depth != 2 {
+		t.Fatalf("shortest failure has the wrong depth. want=2 got=%d found=%d", model.Depth, len(found))
+	}
+}
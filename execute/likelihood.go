@@ -0,0 +1,188 @@
+package execute
+
+import (
+	"fault/util"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// How likely is a scenario? Every state of an uncertain
+// value is weighed against its distribution and the
+// weights are combined into one joint log-probability
+// so scenarios can be compared with each other.
+//
+// For continuous distributions those weights are densities,
+// which can be above 1 and aren't probabilities, so they
+// aren't what -min-probability is compared to. The threshold
+// uses the chance of drawing a value at least as unusual as
+// the one in the scenario instead: 1 at the most likely value,
+// 0 outside the distribution's support. Discrete distributions
+// use the probability of the value itself.
+
+type Distribution = util.Distribution
+
+func (mc *ModelChecker) distribution(id string) Distribution {
+	if u, ok := mc.Distributions[id]; ok && u.Distribution != "" {
+		d, err := util.NewDistribution(u.Distribution, u.Params)
+		if err == nil {
+			return d
+		}
+	}
+
+	// Older models only know the mean and sigma
+	uncertain := mc.Uncertains[id]
+	return distuv.Normal{
+		Mu:    uncertain[0],
+		Sigma: uncertain[1],
+	}
+}
+
+func (mc *ModelChecker) LogProbability(results map[string]Scenario) (float64, bool) {
+	// Returns false if none of the variables in the
+	// scenario are uncertain
+	var lp float64
+	var weighed bool
+	for k := range mc.Uncertains {
+		v, ok := results[k]
+		if !ok {
+			continue
+		}
+
+		dist := mc.distribution(k)
+		switch s := deadBranches(k, copyScenario(v), mc.forks).(type) {
+		case *FloatTrace:
+			for _, state := range s.results {
+				lp += dist.LogProb(state)
+				weighed = true
+			}
+		case *IntTrace:
+			for _, state := range s.results {
+				lp += dist.LogProb(float64(state))
+				weighed = true
+			}
+		case *BoolTrace:
+			for _, state := range s.results {
+				lp += dist.LogProb(boolToFloat(state))
+				weighed = true
+			}
+		}
+	}
+	return lp, weighed
+}
+
+func (mc *ModelChecker) Likely(results map[string]Scenario) bool {
+	// Is the scenario above the minimum probability (if set)
+	if mc.MinProbability <= 0 {
+		return true
+	}
+
+	lp, ok := mc.logTail(results)
+	if !ok {
+		return true
+	}
+	return lp >= math.Log(mc.MinProbability)
+}
+
+func (mc *ModelChecker) logTail(results map[string]Scenario) (float64, bool) {
+	// Same as LogProbability, with tail probabilities
+	// in place of densities
+	var lp float64
+	var weighed bool
+	for k := range mc.Uncertains {
+		v, ok := results[k]
+		if !ok {
+			continue
+		}
+
+		dist := mc.distribution(k)
+		for _, state := range traceStates(deadBranches(k, copyScenario(v), mc.forks)) {
+			lp += math.Log(tailProb(dist, state))
+			weighed = true
+		}
+	}
+	return lp, weighed
+}
+
+func traceStates(v Scenario) []float64 {
+	var states []float64
+	switch s := v.(type) {
+	case *FloatTrace:
+		for _, state := range s.results {
+			states = append(states, state)
+		}
+	case *IntTrace:
+		for _, state := range s.results {
+			states = append(states, float64(state))
+		}
+	case *BoolTrace:
+		for _, state := range s.results {
+			states = append(states, boolToFloat(state))
+		}
+	}
+	return states
+}
+
+const tailSteps = 10000 // quantiles checked when there's no closed form
+
+func tailProb(dist Distribution, x float64) float64 {
+	// Chance of a value with a density no higher than x's
+	switch d := dist.(type) {
+	case distuv.Poisson, distuv.Bernoulli:
+		return d.Prob(x)
+	case distuv.Normal:
+		c := d.CDF(x)
+		return 2 * math.Min(c, 1-c)
+	case distuv.Uniform:
+		if x < d.Min || x > d.Max {
+			return 0
+		}
+		return 1
+	case distuv.Exponential:
+		if x < 0 {
+			return 0
+		}
+		return 1 - d.CDF(x)
+	}
+
+	q, ok := dist.(interface{ Quantile(float64) float64 })
+	if !ok {
+		return dist.Prob(x)
+	}
+
+	// Unimodal without a closed form (lognormal, beta),
+	// count evenly spaced quantiles that are as unusual
+	density := dist.Prob(x)
+	var n int
+	for i := 0; i < tailSteps; i++ {
+		if dist.Prob(q.Quantile((float64(i)+0.5)/tailSteps)) <= density {
+			n++
+		}
+	}
+	return float64(n) / tailSteps
+}
+
+func (mc *ModelChecker) Rank(scenarios []map[string]Scenario) []map[string]Scenario {
+	// Most likely scenario first
+	lps := make([]float64, len(scenarios))
+	for i, s := range scenarios {
+		lp, ok := mc.LogProbability(s)
+		if !ok {
+			lp = math.Inf(-1)
+		}
+		lps[i] = lp
+	}
+
+	idx := make([]int, len(scenarios))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return lps[idx[i]] > lps[idx[j]] })
+
+	ranked := make([]map[string]Scenario, len(scenarios))
+	for i, j := range idx {
+		ranked[i] = scenarios[j]
+	}
+	return ranked
+}
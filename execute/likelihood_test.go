@@ -0,0 +1,177 @@
+package execute
+
+import (
+	"context"
+	"fault/ast"
+	"fault/smt/variables"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func TestLogProbability(t *testing.T) {
+	mc := NewModelCheckerWithSolver(NewZ3("", nil))
+	mc.Uncertains = map[string][]float64{"x": {2.0, 1.0}}
+
+	x := NewFloatTrace()
+	x.Add(0, 2.0)
+	x.Add(1, 3.0)
+	y := NewFloatTrace()
+	y.Add(0, 100.0)
+
+	lp, ok := mc.LogProbability(map[string]Scenario{"x": x, "y": y})
+	if !ok {
+		t.Fatal("scenario with uncertain values was not weighed")
+	}
+
+	dist := distuv.Normal{Mu: 2.0, Sigma: 1.0}
+	expected := math.Log(dist.Prob(2.0)) + math.Log(dist.Prob(3.0))
+	if math.Abs(lp-expected) > 0.000001 {
+		t.Fatalf("joint log-probability is incorrect. want=%f got=%f", expected, lp)
+	}
+
+	_, ok = mc.LogProbability(map[string]Scenario{"y": y})
+	if ok {
+		t.Fatal("scenario without uncertain values was weighed")
+	}
+}
+
+func TestRank(t *testing.T) {
+	mc := NewModelCheckerWithSolver(NewZ3("", nil))
+	mc.Uncertains = map[string][]float64{"x": {2.0, 1.0}}
+
+	var scenarios []map[string]Scenario
+	for _, v := range []float64{5.0, 2.0, 3.0} {
+		x := NewFloatTrace()
+		x.Add(0, v)
+		scenarios = append(scenarios, map[string]Scenario{"x": x})
+	}
+
+	ranked := mc.Rank(scenarios)
+	for i, e := range []float64{2.0, 3.0, 5.0} {
+		got := ranked[i]["x"].(*FloatTrace).results[0]
+		if got != e {
+			t.Fatalf("scenario %d ranked incorrectly. want=%f got=%f", i, e, got)
+		}
+	}
+}
+
+func TestEnumerateMinProbability(t *testing.T) {
+	// Models x=1.0, x=2.0, x=3.0 and then unsat
+	replies := map[string]string{
+		"check-sat": "if [ ${n:-0} -lt 3 ]; then echo sat; else echo unsat; fi",
+		"get-model": `n=$((${n:-0}+1)); echo "((define-fun x_0 () Real $n.0))"`,
+		"get-value": `echo "((x_0 $n.0))"`,
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel("(declare-fun x_0 () Real)", map[string][]float64{"x": {3.0, 0.5}}, []string{}, map[string][]*variables.VarChange{})
+	defer model.Close()
+
+	model.MinProbability = 0.2 // 2.0 is two sigma out, about 0.05
+	found, err := model.Enumerate(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("enumerating scenarios failed. got=%s", err)
+	}
+
+	if len(found) != 1 || found[0]["x"].(*FloatTrace).results[0] != 3.0 {
+		t.Fatalf("unlikely scenarios were not skipped. got=%v", found)
+	}
+
+	if model.Unlikely != 2 {
+		t.Fatalf("wrong number of unlikely scenarios. want=2 got=%d", model.Unlikely)
+	}
+
+	// Nothing is as likely as x=3.0
+	model = NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel("(declare-fun x_0 () Real)", map[string][]float64{"x": {3.5, 0.5}}, []string{}, map[string][]*variables.VarChange{})
+	defer model.Close()
+
+	model.MinProbability = 0.5
+	found, err = model.Enumerate(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("enumerating scenarios failed. got=%s", err)
+	}
+
+	if s := model.Summary(found...); len(found) != 0 || s.Verdict != UNLIKELY || s.Unlikely == 0 {
+		t.Fatalf("run with only unlikely failures reported incorrectly. got=%+v", s)
+	}
+}
+
+func TestTailProb(t *testing.T) {
+	tests := []struct {
+		dist Distribution
+		x    float64
+		want float64
+	}{
+		{distuv.Normal{Mu: 3, Sigma: 0.5}, 3, 1},
+		{distuv.Normal{Mu: 3, Sigma: 0.5}, 2, 0.0455},
+		{distuv.Uniform{Min: 0, Max: 10}, 9.9, 1},
+		{distuv.Uniform{Min: 0, Max: 10}, 12, 0},
+		{distuv.Exponential{Rate: 1}, 0, 1},
+		{distuv.Exponential{Rate: 1}, -1, 0},
+		{distuv.Poisson{Lambda: 2}, 2, 0.2707},
+		{distuv.Beta{Alpha: 2, Beta: 2}, 0.5, 1},
+		{distuv.Beta{Alpha: 2, Beta: 2}, 0.1, 0.056},
+	}
+
+	for _, tt := range tests {
+		got := tailProb(tt.dist, tt.x)
+		if math.Abs(got-tt.want) > 0.001 {
+			t.Fatalf("tail probability of %f in %T is incorrect. want=%f got=%f", tt.x, tt.dist, tt.want, got)
+		}
+
+		if got > 1 || got < 0 {
+			t.Fatalf("tail probability of %f in %T is not a probability. got=%f", tt.x, tt.dist, got)
+		}
+	}
+}
+
+func TestDistributionWeights(t *testing.T) {
+	mc := NewModelCheckerWithSolver(NewZ3("", nil))
+	mc.Uncertains = map[string][]float64{"x": {5.0, 2.886751}}
+	mc.Distributions = map[string]*ast.Uncertain{"x": {Distribution: "uniform", Params: []float64{0, 10}}}
+
+	x := NewFloatTrace()
+	x.Add(0, 2.0)
+	x.Add(1, 12.0)
+
+	weighted := mc.Filter(map[string]Scenario{"x": x})
+	weights := weighted["x"].(*FloatTrace).GetWeights()
+	if weights[0] != 0.1 {
+		t.Fatalf("value inside a uniform distribution weighted incorrectly. want=0.1 got=%f", weights[0])
+	}
+
+	if weights[1] != 0 {
+		t.Fatalf("value outside a uniform distribution weighted incorrectly. want=0 got=%f", weights[1])
+	}
+}
+
+func TestBernoulliWeights(t *testing.T) {
+	mc := NewModelCheckerWithSolver(NewZ3("", nil))
+	mc.Uncertains = map[string][]float64{"open": {0.2, 0.4}}
+	mc.Distributions = map[string]*ast.Uncertain{"open": {Distribution: "bernoulli", Params: []float64{0.2}}}
+
+	open := NewBoolTrace()
+	open.Add(0, true)
+	open.Add(1, false)
+
+	weighted := mc.Filter(map[string]Scenario{"open": open})
+	weights := weighted["open"].(*BoolTrace).GetWeights()
+	if weights[0] != 0.2 {
+		t.Fatalf("true weighted incorrectly. want=0.2 got=%f", weights[0])
+	}
+
+	if weights[1] != 0.8 {
+		t.Fatalf("false weighted incorrectly. want=0.8 got=%f", weights[1])
+	}
+
+	lp, ok := mc.LogProbability(weighted)
+	if !ok {
+		t.Fatal("boolean uncertain value not weighed")
+	}
+
+	if math.Abs(lp-math.Log(0.2*0.8)) > 1e-9 {
+		t.Fatalf("log-probability incorrect. want=%f got=%f", math.Log(0.2*0.8), lp)
+	}
+}
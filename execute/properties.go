@@ -0,0 +1,88 @@
+package execute
+
+import (
+	"context"
+	"fault/smt/properties"
+)
+
+// Checks each assert on its own against the shared rules
+// and assumes. Every assert gets its own scope so one
+// violation doesn't hide the others, and a design change
+// shows exactly which properties it breaks.
+
+const (
+	HOLDS    = "holds"
+	VIOLATED = "violated"
+)
+
+type PropertyResult struct {
+	Property *properties.Property
+	Status   string // holds, violated, unknown, proved or not proved
+	Reason   string // why the solver returned unknown, or why there's no proof
+	Round    int    // where the property was violated, counting from 1 (0 if unclear or before the run block)
+	K        int    // induction depth of a proof in rounds of the run block, see Prove
+	Scenario map[string]Scenario
+}
+
+func (mc *ModelChecker) CheckProperties(ctx context.Context) ([]*PropertyResult, error) {
+	var results []*PropertyResult
+	for _, p := range mc.Properties {
+		if p.Assume {
+			continue
+		}
+
+		r, err := mc.checkProperty(ctx, p)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+func (mc *ModelChecker) checkProperty(ctx context.Context, p *properties.Property) (*PropertyResult, error) {
+	err := mc.Push(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if mc.session != nil {
+			mc.Pop(ctx)
+		}
+	}()
+
+	err = mc.Assert(ctx, "(assert "+p.Named()+")")
+	if err != nil {
+		return nil, err
+	}
+
+	verdict, err := mc.CheckContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PropertyResult{Property: p}
+	switch verdict {
+	case UNSAT:
+		r.Status = HOLDS
+	case UNKNOWN:
+		r.Status = string(UNKNOWN)
+		r.Reason = mc.Reason
+	default:
+		r.Status = VIOLATED
+		scenario, err := mc.SolveContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		clause, err := mc.firstTrue(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		if clause != -1 {
+			r.Round = mc.specRound(p.Round(clause))
+		}
+		r.Scenario = mc.Filter(scenario)
+	}
+	return r, nil
+}
@@ -0,0 +1,72 @@
+package execute
+
+import (
+	"context"
+	"fault/smt/properties"
+	"fault/smt/variables"
+	"testing"
+)
+
+func TestCheckProperties(t *testing.T) {
+	// assert_0 can be violated, assert_1 can't
+	replies := map[string]string{
+		":named assert_0": "p=0",
+		":named assert_1": "p=1",
+		"check-sat":       `if [ "$p" = "0" ]; then echo sat; else echo unsat; fi`,
+		"get-model":       `echo "((define-fun x_0 () Real 10.0) (define-fun x_1 () Real 0.0))"`,
+		"get-value":       `echo "(((<= x_0 0) false) ((<= x_1 0) true))"`,
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel("(declare-fun x_0 () Real)(declare-fun x_1 () Real)", make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	model.Properties = []*properties.Property{
+		{
+			Name:     "assert_0",
+			Position: []int{22, 1, 22, 20},
+			Rule:     "(or (<= x_0 0) (<= x_1 0))",
+			Clauses:  []string{"(<= x_0 0)", "(<= x_1 0)"},
+			Rounds:   []int{0, 1},
+		},
+		{
+			Name:     "assume_0",
+			Position: []int{23, 1, 23, 20},
+			Assume:   true,
+			Rule:     "(> x_0 5)",
+			Clauses:  []string{"(> x_0 5)"},
+			Rounds:   []int{0},
+		},
+		{
+			Name:     "assert_1",
+			Position: []int{24, 1, 24, 20},
+			Rule:     "(> x_0 100)",
+			Clauses:  []string{"(> x_0 100)"},
+			Rounds:   []int{0},
+		},
+	}
+	defer model.Close()
+
+	results, err := model.CheckProperties(context.Background())
+	if err != nil {
+		t.Fatalf("checking properties failed. got=%s", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("wrong number of results, assumes aren't checked. want=2 got=%d", len(results))
+	}
+
+	if results[0].Status != VIOLATED || results[0].Round != 2 || results[0].Scenario == nil {
+		t.Fatalf("assert_0 result is incorrect. got=%+v", results[0])
+	}
+
+	if results[1].Status != HOLDS || results[1].Scenario != nil {
+		t.Fatalf("assert_1 result is incorrect. got=%+v", results[1])
+	}
+
+	if propertyStatus(results[0]) != "violated in round 2" {
+		t.Fatalf("status formatted incorrectly. got=%s", propertyStatus(results[0]))
+	}
+
+	unknown := &PropertyResult{Status: string(UNKNOWN), Reason: "timeout"}
+	if propertyStatus(unknown) != "unknown (timeout)" {
+		t.Fatalf("status formatted incorrectly. got=%s", propertyStatus(unknown))
+	}
+}
@@ -0,0 +1,197 @@
+package execute
+
+import (
+	"context"
+	"errors"
+	"fault/smt/properties"
+	"fault/util"
+	"fmt"
+	"strings"
+)
+
+// Proves asserts for every round count by k-induction. The
+// base case is the bounded check: no violation in the first
+// k rounds. The step case starts the unrolled rounds from
+// any state instead of the initial values: if k rounds in a
+// row without a violation can't be followed by one, the
+// assert holds no matter how many rounds are run.
+
+const (
+	PROVED   = "proved"
+	UNPROVED = "not proved"
+)
+
+func (mc *ModelChecker) Prove(ctx context.Context) ([]*PropertyResult, error) {
+	if len(mc.VarRounds) == 0 {
+		// Can't tell the initial values apart, starting
+		// from them would prove only the bounded rounds
+		return nil, errors.New("proving needs the states of every variable from the generator")
+	}
+
+	step := NewModelCheckerWithSolver(mc.solver)
+	step.LoadModel(mc.stepModel(), mc.Uncertains, mc.Unknowns, mc.Results)
+	defer step.Close()
+
+	var results []*PropertyResult
+	for _, p := range mc.Properties {
+		if p.Assume {
+			continue
+		}
+
+		r, err := mc.prove(ctx, step, p)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+func (mc *ModelChecker) prove(ctx context.Context, step *ModelChecker, p *properties.Property) (*PropertyResult, error) {
+	r := &PropertyResult{Property: p, Status: UNPROVED}
+	if p.Temporal || len(p.Clauses) == 0 {
+		r.Reason = "not an invariant"
+		return r, nil
+	}
+
+	var rounds int
+	for _, n := range p.Rounds {
+		if n+1 > rounds {
+			rounds = n + 1
+		}
+	}
+
+	for k := 1; k <= rounds; k++ {
+		// Base case, the rounds before k were checked already
+		if base := roundClauses(p, k-1); len(base) > 0 {
+			verdict, scenario, err := mc.solveScoped(ctx, "(assert "+disjunction(base)+")")
+			if err != nil {
+				return nil, err
+			}
+
+			switch verdict {
+			case SAT:
+				r.Status = VIOLATED
+				r.Round = mc.specRound(k - 1)
+				r.Scenario = mc.Filter(scenario)
+				return r, nil
+			case UNKNOWN:
+				r.Status = string(UNKNOWN)
+				r.Reason = mc.Reason
+				return r, nil
+			}
+		}
+
+		if k == rounds { // No round left to step into
+			break
+		}
+
+		next := roundClauses(p, k)
+		if len(next) == 0 {
+			continue
+		}
+
+		var rules []string
+		for i, c := range p.Clauses {
+			if p.Round(i) < k {
+				rules = append(rules, fmt.Sprintf("(assert (not %s))", c))
+			}
+		}
+		rules = append(rules, "(assert "+disjunction(next)+")")
+
+		verdict, scenario, err := step.solveScoped(ctx, rules...)
+		if err != nil {
+			return nil, err
+		}
+
+		r.K = mc.depth(k)
+		switch verdict {
+		case UNSAT:
+			r.Status = PROVED
+			r.Scenario = nil
+			return r, nil
+		case UNKNOWN:
+			r.Status = string(UNKNOWN)
+			r.Reason = step.Reason
+			return r, nil
+		default: // Not inductive yet, try a longer run of good rounds
+			r.Scenario = mc.Filter(scenario)
+		}
+	}
+
+	if r.K == 0 {
+		r.Reason = "needs at least two rounds"
+	}
+	return r, nil
+}
+
+func (mc *ModelChecker) checkScoped(ctx context.Context, rules ...string) (Verdict, error) {
+	// The verdict alone, the model is never read
+	verdict, _, err := mc.scoped(ctx, false, rules)
+	return verdict, err
+}
+
+func (mc *ModelChecker) solveScoped(ctx context.Context, rules ...string) (Verdict, map[string]Scenario, error) {
+	return mc.scoped(ctx, true, rules)
+}
+
+func (mc *ModelChecker) scoped(ctx context.Context, solve bool, rules []string) (Verdict, map[string]Scenario, error) {
+	// Checks the rules in their own scope, so they're
+	// gone again once the check is done
+	err := mc.Push(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	defer func() {
+		if mc.session != nil {
+			mc.Pop(ctx)
+		}
+	}()
+
+	err = mc.Assert(ctx, rules...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	verdict, err := mc.CheckContext(ctx)
+	if err != nil || verdict != SAT || !solve {
+		return verdict, nil, err
+	}
+
+	scenario, err := mc.SolveContext(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return verdict, scenario, nil
+}
+
+func roundClauses(p *properties.Property, round int) []string {
+	var clauses []string
+	for i, c := range p.Clauses {
+		if p.Round(i) == round {
+			clauses = append(clauses, c)
+		}
+	}
+	return clauses
+}
+
+func disjunction(clauses []string) string {
+	if len(clauses) == 1 {
+		return clauses[0]
+	}
+	return fmt.Sprintf("(or %s)", strings.Join(clauses, " "))
+}
+
+func (mc *ModelChecker) stepModel() string {
+	// The model without the initial values of anything
+	// that changes, so the rounds can start anywhere
+	var out []string
+	for _, cmd := range util.SexprList("(" + mc.SMT + ")") {
+		parts := util.SexprList(cmd)
+		if len(parts) == 2 && parts[0] == "assert" && !strings.HasPrefix(parts[1], "(!") && mc.initRule(parts[1]) {
+			continue
+		}
+		out = append(out, cmd)
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,164 @@
+package execute
+
+import (
+	"context"
+	"fault/smt/properties"
+	"fault/smt/variables"
+	"strings"
+	"testing"
+)
+
+func TestStepModel(t *testing.T) {
+	// z goes from z_0 to z_2 in a branch, there's no z_1
+	mc := NewModelChecker()
+	mc.SMT = `(declare-fun x_0 () Real)
+(declare-fun x_1 () Real)
+(declare-fun y_0 () Real)
+(declare-fun z_0 () Real)
+(declare-fun z_2 () Real)
+(assert (= x_0 10.0))
+(assert (= y_0 2.0))
+(assert (= z_0 0.0))
+(assert (= x_1 (- x_0 y_0)))
+(assert (= z_2 (+ z_0 y_0)))
+(assert (! (> x_0 5) :named assume_0))`
+	mc.VarRounds = map[string][][]int{
+		"x": {{0, 0, 0}, {1, 1, 0}},
+		"y": {{0, 0, 1}},
+		"z": {{0, 0, 2}, {2, 1, 1}},
+	}
+
+	step := mc.stepModel()
+	for _, init := range []string{"(= x_0 10.0)", "(= z_0 0.0)"} {
+		if strings.Contains(step, init) {
+			t.Fatalf("initial value of a changing variable not removed. got=%s", step)
+		}
+	}
+
+	for _, keep := range []string{"(= y_0 2.0)", "(= x_1 (- x_0 y_0))", "(= z_2 (+ z_0 y_0))", ":named assume_0", "(declare-fun x_0 () Real)"} {
+		if !strings.Contains(step, keep) {
+			t.Fatalf("step model is missing %s. got=%s", keep, step)
+		}
+	}
+}
+
+func TestProve(t *testing.T) {
+	// The bounded model never fails. Started from anywhere the
+	// first round can fail, but not after two good rounds.
+	replies := map[string]string{
+		"(= x_0 10.0)":        "init=1",
+		"pop":                 "w=0",
+		"(assert (<= x_1 0))": "w=1",
+		"check-sat":           `if [ "$init" != "1" ] && [ "$w" = "1" ]; then echo sat; else echo unsat; fi`,
+		"get-model":           `echo "((define-fun x_0 () Real 1.0) (define-fun x_1 () Real 0.0))"`,
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel(`(declare-fun x_0 () Real)
+(declare-fun x_1 () Real)
+(declare-fun x_2 () Real)
+(assert (= x_0 10.0))
+(assert (= x_1 (- x_0 2.0)))
+(assert (= x_2 (- x_1 2.0)))`, make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	model.VarRounds = map[string][][]int{"x": {{0, 0, 0}, {1, 1, 0}, {2, 2, 0}}}
+	model.Properties = []*properties.Property{
+		{
+			Name:     "assert_0",
+			Position: []int{22, 1},
+			Rule:     "(or (<= x_0 0) (<= x_1 0) (<= x_2 0))",
+			Clauses:  []string{"(<= x_0 0)", "(<= x_1 0)", "(<= x_2 0)"},
+			Rounds:   []int{0, 1, 2},
+		},
+		{
+			Name:     "assert_1",
+			Position: []int{23, 1},
+			Temporal: true,
+			Rule:     "(<= x_2 0)",
+			Clauses:  []string{"(<= x_2 0)"},
+			Rounds:   []int{2},
+		},
+	}
+	defer model.Close()
+
+	results, err := model.Prove(context.Background())
+	if err != nil {
+		t.Fatalf("proving properties failed. got=%s", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("wrong number of results. want=2 got=%d", len(results))
+	}
+
+	if results[0].Status != PROVED || results[0].K != 2 || results[0].Scenario != nil {
+		t.Fatalf("assert_0 result is incorrect. got=%+v", results[0])
+	}
+
+	if propertyStatus(results[0]) != "proved for all rounds at k=2" {
+		t.Fatalf("status formatted incorrectly. got=%s", propertyStatus(results[0]))
+	}
+
+	if propertyStatus(results[1]) != "not proved (not an invariant)" {
+		t.Fatalf("status formatted incorrectly. got=%s", propertyStatus(results[1]))
+	}
+
+	// With a constant the first round is setup, so two of
+	// the generator's rounds are one round of the run block
+	model.RunRounds = []int{0, 1, 2}
+	results, err = model.Prove(context.Background())
+	if err != nil {
+		t.Fatalf("proving properties failed. got=%s", err)
+	}
+
+	if results[0].Status != PROVED || results[0].K != 1 {
+		t.Fatalf("proof depth is incorrect. got=%+v", results[0])
+	}
+
+	unproved := &PropertyResult{Status: UNPROVED, K: 2, Scenario: map[string]Scenario{}}
+	if propertyStatus(unproved) != "not proved, inductive counterexample at k=2" {
+		t.Fatalf("status formatted incorrectly. got=%s", propertyStatus(unproved))
+	}
+}
+
+func TestProveViolated(t *testing.T) {
+	replies := map[string]string{
+		"pop":                 "w=0",
+		"(assert (<= x_1 0))": "w=1",
+		"check-sat":           `if [ "$w" = "1" ]; then echo sat; else echo unsat; fi`,
+		"get-model":           `echo "((define-fun x_0 () Real 1.0) (define-fun x_1 () Real 0.0))"`,
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel(`(declare-fun x_0 () Real)
+(declare-fun x_1 () Real)
+(assert (= x_0 1.0))
+(assert (= x_1 (- x_0 1.0)))`, make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	model.VarRounds = map[string][][]int{"x": {{0, 0, 0}, {1, 1, 0}}}
+	model.Properties = []*properties.Property{
+		{
+			Name:    "assert_0",
+			Rule:    "(or (<= x_0 0) (<= x_1 0))",
+			Clauses: []string{"(<= x_0 0)", "(<= x_1 0)"},
+			Rounds:  []int{0, 1},
+		},
+	}
+	defer model.Close()
+
+	results, err := model.Prove(context.Background())
+	if err != nil {
+		t.Fatalf("proving properties failed. got=%s", err)
+	}
+
+	if len(results) != 1 || results[0].Status != VIOLATED || results[0].Round != 2 || results[0].Scenario == nil {
+		t.Fatalf("violation not found by the base case. got=%+v", results[0])
+	}
+
+	// With a constant the first round is setup, x_1
+	// is set in the first round of the run block
+	model.RunRounds = []int{0, 1}
+	results, err = model.Prove(context.Background())
+	if err != nil {
+		t.Fatalf("proving properties failed. got=%s", err)
+	}
+
+	if len(results) != 1 || results[0].Status != VIOLATED || results[0].Round != 1 {
+		t.Fatalf("violation round is incorrect. got=%+v", results[0])
+	}
+}
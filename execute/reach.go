@@ -0,0 +1,88 @@
+package execute
+
+import (
+	"context"
+	"fault/smt/properties"
+	"fmt"
+)
+
+// Reachability with the guards taken into account. Every
+// state and transition of a system comes in as a query, if
+// the model without its asserts has no run where the query
+// is true, no run of that many rounds enters the state or
+// fires the transition. Transitions are judged on the states
+// at the end of each round, one that fires and is undone
+// within the same round isn't seen and is reported as never
+// firing.
+
+type Unreachable struct {
+	Name   string `json:"name"` // a.foo or a.foo -> a.bar
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	Reason string `json:"reason,omitempty"` // why the solver returned unknown
+}
+
+func (u *Unreachable) String(file string) string {
+	if u.Line == 0 {
+		return u.Name
+	}
+	return fmt.Sprintf("%s at %s", u.Name, specLine(file, u.Line))
+}
+
+type ReachReport struct {
+	States      []*Unreachable `json:"states,omitempty"`      // never entered
+	Transitions []*Unreachable `json:"transitions,omitempty"` // never fire between the end of one round and the next
+	Unknown     []*Unreachable `json:"unknown,omitempty"`     // the solver couldn't say
+}
+
+func (r *ReachReport) Complete() bool {
+	return len(r.States) == 0 && len(r.Transitions) == 0
+}
+
+func (mc *ModelChecker) Reachability(ctx context.Context, states []*properties.Property, transitions []*properties.Property) (*ReachReport, error) {
+	base := NewModelCheckerWithSolver(mc.solver)
+	base.LoadModel(baseModel(mc.SMT), mc.Uncertains, mc.Unknowns, mc.Results)
+	defer base.Close()
+
+	report := &ReachReport{}
+	var err error
+	report.States, err = base.unreachable(ctx, states, report)
+	if err != nil {
+		return nil, err
+	}
+
+	report.Transitions, err = base.unreachable(ctx, transitions, report)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (mc *ModelChecker) unreachable(ctx context.Context, queries []*properties.Property, report *ReachReport) ([]*Unreachable, error) {
+	var never []*Unreachable
+	for _, q := range queries {
+		u := &Unreachable{Name: q.Label, Line: q.Line()}
+		if len(q.Position) > 1 {
+			u.Column = q.Position[1]
+		}
+
+		if q.Rule == "false" { // Not a single round to be in
+			never = append(never, u)
+			continue
+		}
+
+		verdict, err := mc.checkScoped(ctx, "(assert "+q.Rule+")")
+		if err != nil {
+			return nil, err
+		}
+
+		switch verdict {
+		case UNSAT:
+			never = append(never, u)
+		case UNKNOWN:
+			u.Reason = mc.Reason
+			report.Unknown = append(report.Unknown, u)
+		}
+	}
+	return never, nil
+}
@@ -0,0 +1,51 @@
+package execute
+
+import (
+	"context"
+	"fault/smt/properties"
+	"fault/smt/variables"
+	"testing"
+)
+
+func TestReachability(t *testing.T) {
+	// busy is guarded by something that's never true
+	replies := map[string]string{
+		"(assert busy_0)":              "busy=1",
+		"(assert (and idle_0 busy_0))": "busy=1",
+		"pop":                          "busy=0",
+		"check-sat":                    `if [ "$busy" = "1" ]; then echo unsat; else echo sat; fi`,
+		"get-model":                    "exit 1", // only the verdict is needed
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel(`(declare-fun idle_0 () Bool)
+(declare-fun busy_0 () Bool)
+(assert (! (or busy_0) :named assert_0))`, make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	model.File = "specs/reach.fsystem"
+	defer model.Close()
+
+	states := []*properties.Property{
+		{Name: "query_0", Label: "a.idle", Position: []int{5, 2}, Rule: "idle_0"},
+		{Name: "query_1", Label: "a.busy", Position: []int{10, 2}, Rule: "busy_0"},
+		{Name: "query_2", Label: "a.done", Position: []int{13, 2}, Rule: "false"},
+	}
+	transitions := []*properties.Property{
+		{Name: "query_0", Label: "a.idle -> a.busy", Position: []int{7, 4}, Rule: "(and idle_0 busy_0)"},
+	}
+
+	r, err := model.Reachability(context.Background(), states, transitions)
+	if err != nil {
+		t.Fatalf("checking reachability failed. got=%s", err)
+	}
+
+	if r.Complete() || len(r.States) != 2 || len(r.Transitions) != 1 || len(r.Unknown) != 0 {
+		t.Fatalf("report is incorrect. got=%+v", r)
+	}
+
+	if r.States[0].Name != "a.busy" || r.States[0].Column != 2 || r.States[1].Name != "a.done" {
+		t.Fatalf("unreachable states are incorrect. got=%+v %+v", r.States[0], r.States[1])
+	}
+
+	if r.Transitions[0].String(model.File) != "a.idle -> a.busy at reach.fsystem:7" {
+		t.Fatalf("unreachable transition formatted incorrectly. got=%s", r.Transitions[0].String(model.File))
+	}
+}
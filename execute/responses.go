@@ -132,8 +132,12 @@ func (l *SMTListener) ExitTerm(c *parser.TermContext) {
 			parts = append([]string{p.(string)}, parts...)
 		}
 		term = mergeTermParts(parts)
-		if strings.Contains(term, "-") {
-			term = fmt.Sprintf("-%s", term)
+		if strings.HasPrefix(c.GetText(), "(-") { // negation, not a negative part
+			if strings.HasPrefix(term, "-") {
+				term = term[1:]
+			} else {
+				term = fmt.Sprintf("-%s", term)
+			}
 		}
 	}
 	l.push(term)
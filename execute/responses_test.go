@@ -85,6 +85,30 @@ func TestParseBools(t *testing.T) {
 	}
 }
 
+func TestParseNegSign(t *testing.T) {
+	test := `(model 
+		(define-fun imports_fl3_vault_value_2 () Real
+		  (- 20.0))
+		(define-fun imports_fl3_vault_value_3 () Real
+		  (- (/ 1.0 2.0)))
+	  )
+	  `
+	response := prepTestParser(test)
+
+	v, ok := response["imports_fl3_vault_value"].(*FloatTrace)
+	if !ok {
+		t.Fatalf("SMT parser failed to parse reals in solution returned. got=%s", response)
+	}
+
+	if v.results[2] != -20.0 {
+		t.Fatalf("SMT parser dropped the sign of a negative value. got=%f", v.results[2])
+	}
+
+	if v.results[3] != -0.5 {
+		t.Fatalf("SMT parser dropped the sign of a negative value. got=%f", v.results[3])
+	}
+}
+
 func prepTestParser(response string) map[string]Scenario {
 	is := antlr.NewInputStream(response)
 	lexer := parser.NewSMTLIBv2Lexer(is)
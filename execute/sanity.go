@@ -0,0 +1,130 @@
+package execute
+
+import (
+	"context"
+	"fault/util"
+	"fmt"
+	"strings"
+)
+
+// Checks that pass for the wrong reason. If the rules and
+// assumes can't be satisfied without any asserts there's
+// no behavior left to violate, and a when/then assert whose
+// when can never be true can't be violated either.
+
+type Warning struct {
+	Kind    string `json:"kind"` // contradiction or vacuous
+	Name    string `json:"name,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+func (w *Warning) String() string {
+	return "warning: " + w.Message
+}
+
+func (mc *ModelChecker) Sanity(ctx context.Context) ([]*Warning, error) {
+	base := NewModelCheckerWithSolver(mc.solver)
+	base.LoadModel(baseModel(mc.SMT), mc.Uncertains, mc.Unknowns, mc.Results)
+	base.Properties = mc.Properties
+	base.Positions = mc.Positions
+	defer base.Close()
+
+	verdict, err := base.CheckContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []*Warning
+	switch verdict {
+	case UNSAT:
+		warnings, err = base.contradictions(ctx, mc.File)
+	case SAT:
+		warnings, err = base.vacuous(ctx, mc.File)
+	}
+	if err != nil {
+		return nil, err
+	}
+	mc.Warnings = warnings
+	return warnings, nil
+}
+
+func (mc *ModelChecker) contradictions(ctx context.Context, file string) ([]*Warning, error) {
+	core, err := mc.Explain(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []*Warning
+	for _, c := range core {
+		if c.Kind != "assume" {
+			continue
+		}
+		warnings = append(warnings, &Warning{
+			Kind:    "contradiction",
+			Name:    c.Name,
+			Line:    c.Line,
+			Message: fmt.Sprintf("assume at %s contradicts the model, checks pass trivially", specLine(file, c.Line)),
+		})
+	}
+
+	if len(warnings) == 0 {
+		warnings = append(warnings, &Warning{
+			Kind:    "contradiction",
+			Message: "the model's rules contradict each other, checks pass trivially",
+		})
+	}
+	return warnings, nil
+}
+
+func (mc *ModelChecker) vacuous(ctx context.Context, file string) ([]*Warning, error) {
+	var warnings []*Warning
+	for _, p := range mc.Properties {
+		if p.Assume || p.Antecedent == "" {
+			continue
+		}
+
+		err := mc.Push(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = mc.Assert(ctx, fmt.Sprintf("(assert %s)", p.Antecedent))
+		if err != nil {
+			return nil, err
+		}
+
+		verdict, err := mc.CheckContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = mc.Pop(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if verdict == UNSAT {
+			warnings = append(warnings, &Warning{
+				Kind:    "vacuous",
+				Name:    p.Name,
+				Line:    p.Line(),
+				Message: fmt.Sprintf("assert at %s is vacuous, its when condition is never true", specLine(file, p.Line())),
+			})
+		}
+	}
+	return warnings, nil
+}
+
+func baseModel(smt string) string {
+	// The model without its asserts, only the rules
+	// and the assumes
+	var out []string
+	for _, cmd := range util.SexprList("(" + smt + ")") {
+		if strings.Contains(cmd, ":named assert_") {
+			continue
+		}
+		out = append(out, cmd)
+	}
+	return strings.Join(out, "\n")
+}
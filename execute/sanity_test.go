@@ -0,0 +1,91 @@
+package execute
+
+import (
+	"context"
+	"fault/smt/properties"
+	"fault/smt/variables"
+	"strings"
+	"testing"
+)
+
+func TestBaseModel(t *testing.T) {
+	smt := `(declare-fun x_0 () Real)
+(assert (= x_0 10.0))
+(assert (! (<= x_0 0) :named assert_0))
+(assert (! (> x_0 5) :named assume_0))`
+
+	base := baseModel(smt)
+	if strings.Contains(base, "assert_0") {
+		t.Fatalf("asserts not removed from the model. got=%s", base)
+	}
+
+	if !strings.Contains(base, "(assert (= x_0 10.0))") || !strings.Contains(base, ":named assume_0") {
+		t.Fatalf("rules or assumes removed from the model. got=%s", base)
+	}
+}
+
+func TestSanityContradiction(t *testing.T) {
+	replies := map[string]string{
+		"check-sat":      "echo unsat",
+		"get-unsat-core": `echo "(assume_0 rule_0)"`,
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel(`(declare-fun x_0 () Real)
+(assert (= x_0 10.0))
+(assert (! (<= x_0 0) :named assert_0))
+(assert (! (< x_0 5) :named assume_0))`, make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	model.Properties = []*properties.Property{
+		{Name: "assert_0", Position: []int{20, 1}},
+		{Name: "assume_0", Position: []int{22, 1}, Assume: true},
+	}
+	model.File = "bathtub.fspec"
+
+	warnings, err := model.Sanity(context.Background())
+	if err != nil {
+		t.Fatalf("sanity check failed. got=%s", err)
+	}
+
+	if len(warnings) != 1 || warnings[0].Kind != "contradiction" || warnings[0].Line != 22 {
+		t.Fatalf("contradiction not found. got=%v", warnings)
+	}
+
+	if warnings[0].String() != "warning: assume at bathtub.fspec:22 contradicts the model, checks pass trivially" {
+		t.Fatalf("warning message is incorrect. got=%s", warnings[0])
+	}
+
+	if summary := model.Summary(); len(summary.Warnings) != 1 {
+		t.Fatalf("warnings missing from summary. got=%v", summary.Warnings)
+	}
+}
+
+func TestSanityVacuous(t *testing.T) {
+	// The model is sat, but not with the when of the assert
+	replies := map[string]string{
+		"(assert (> x_1 100))": "w=1",
+		"pop":                  "w=0",
+		"check-sat":            `if [ "$w" = 1 ]; then echo unsat; else echo sat; fi`,
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel(`(declare-fun x_0 () Real)
+(declare-fun x_1 () Real)
+(assert (= x_0 10.0))
+(assert (! (and (> x_1 100) (<= x_0 0)) :named assert_0))`, make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	model.Properties = []*properties.Property{
+		{Name: "assert_0", Position: []int{20, 1}, Antecedent: "(> x_1 100)"},
+		{Name: "assert_1", Position: []int{21, 1}},
+	}
+	model.File = "bathtub.fspec"
+
+	warnings, err := model.Sanity(context.Background())
+	if err != nil {
+		t.Fatalf("sanity check failed. got=%s", err)
+	}
+
+	if len(warnings) != 1 || warnings[0].Kind != "vacuous" || warnings[0].Name != "assert_0" {
+		t.Fatalf("vacuous assert not found. got=%v", warnings)
+	}
+
+	if warnings[0].String() != "warning: assert at bathtub.fspec:20 is vacuous, its when condition is never true" {
+		t.Fatalf("warning message is incorrect. got=%s", warnings[0])
+	}
+}
@@ -0,0 +1,226 @@
+package execute
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// A single long running solver process. Commands are
+// written to the solver's stdin and responses are read
+// back from stdout one s-expression at a time so that
+// the model only has to be parsed by the solver once.
+
+const syncMarker = "fault-sync"
+
+type session struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+	stderr *lockedBuffer
+}
+
+// The solver's stderr is written by the goroutine exec
+// starts to copy it, and read when a response fails
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func newSession(s Solver) (*session, error) {
+	command, args := s.Command()
+	cmd := exec.Command(command, args...)
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stderr := &lockedBuffer{}
+	cmd.Stderr = stderr
+
+	err = cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	return &session{
+		cmd:    cmd,
+		stdin:  stdin,
+		stdout: bufio.NewReader(stdout),
+		stderr: stderr,
+	}, nil
+}
+
+func (s *session) send(commands ...string) error {
+	_, err := io.WriteString(s.stdin, strings.Join(commands, "\n")+"\n")
+	return err
+}
+
+func (s *session) read() (string, error) {
+	r, err := readResponse(s.stdout)
+	if err == nil {
+		return r, nil
+	}
+
+	if msg := strings.TrimSpace(s.stderr.String()); msg != "" {
+		return r, fmt.Errorf("%s: %s", err, msg)
+	}
+	return r, err
+}
+
+func (s *session) readContext(ctx context.Context) (string, error) {
+	// Same as read, but gives up on the solver (and kills it)
+	// once the context is done. The session can't be used
+	// again after that.
+	if ctx.Done() == nil {
+		return s.read()
+	}
+
+	type response struct {
+		r   string
+		err error
+	}
+
+	ch := make(chan response, 1)
+	go func() {
+		r, err := s.read()
+		ch <- response{r, err}
+	}()
+
+	select {
+	case res := <-ch:
+		return res.r, res.err
+	case <-ctx.Done():
+		s.cmd.Process.Kill()
+		<-ch // killing the solver closes stdout, wait for the read to finish
+		s.stdin.Close()
+		s.cmd.Wait()
+		return "", ctx.Err()
+	}
+}
+
+func (s *session) sync(ctx context.Context) error {
+	// Most commands (declare-fun, assert, push, pop) don't
+	// respond unless they fail, so echo a marker and read
+	// everything up to it to catch errors as they happen.
+	err := s.send(fmt.Sprintf("(echo \"%s\")", syncMarker))
+	if err != nil {
+		return err
+	}
+
+	var solverErr error
+	for {
+		r, err := s.readContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		if strings.Trim(r, "\"") == syncMarker {
+			return solverErr
+		}
+
+		if solverErr == nil && strings.HasPrefix(r, "(error") {
+			solverErr = errors.New(r)
+		}
+	}
+}
+
+func (s *session) close() error {
+	s.send("(exit)")
+	s.stdin.Close()
+	return s.cmd.Wait()
+}
+
+func readResponse(r *bufio.Reader) (string, error) {
+	// Reads one complete response from the solver: either
+	// an atom (sat, unsat, "a string") or a balanced
+	// s-expression that may span several lines
+	var out bytes.Buffer
+	var depth int
+	var inString, inQuoted bool
+
+	for {
+		ch, err := r.ReadByte()
+		if err != nil {
+			if err == io.EOF && out.Len() > 0 && depth == 0 {
+				return out.String(), nil
+			}
+			if err == io.EOF {
+				return out.String(), errors.New("solver closed unexpectedly")
+			}
+			return out.String(), err
+		}
+
+		switch {
+		case inString:
+			out.WriteByte(ch)
+			if ch == '"' {
+				// "" is an escaped quote in SMTLib
+				next, err := r.Peek(1)
+				if err == nil && next[0] == '"' {
+					r.ReadByte()
+					out.WriteByte('"')
+					continue
+				}
+				inString = false
+				if depth == 0 {
+					return out.String(), nil
+				}
+			}
+		case inQuoted:
+			out.WriteByte(ch)
+			if ch == '|' {
+				inQuoted = false
+			}
+		case ch == '"':
+			inString = true
+			out.WriteByte(ch)
+		case ch == '|':
+			inQuoted = true
+			out.WriteByte(ch)
+		case ch == '(':
+			depth++
+			out.WriteByte(ch)
+		case ch == ')':
+			depth--
+			out.WriteByte(ch)
+			if depth == 0 {
+				return out.String(), nil
+			}
+		case ch == ' ' || ch == '\n' || ch == '\t' || ch == '\r':
+			if depth == 0 {
+				if out.Len() > 0 { // end of an atom
+					return out.String(), nil
+				}
+				continue
+			}
+			out.WriteByte(ch)
+		default:
+			out.WriteByte(ch)
+		}
+	}
+}
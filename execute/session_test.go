@@ -0,0 +1,45 @@
+package execute
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+)
+
+func TestReadResponse(t *testing.T) {
+	test := `sat
+(
+  (define-fun imports_fl3_vault_value_0 () Real
+    30.0)
+  (define-fun imports_fl3_vault_value_1 () Real
+    (- 2.0))
+)
+"fault-sync"
+(error "line 3 column 10: unknown constant (foo)")
+unsat`
+
+	expected := []string{
+		"sat",
+		"(\n  (define-fun imports_fl3_vault_value_0 () Real\n    30.0)\n  (define-fun imports_fl3_vault_value_1 () Real\n    (- 2.0))\n)",
+		"\"fault-sync\"",
+		"(error \"line 3 column 10: unknown constant (foo)\")",
+		"unsat",
+	}
+
+	r := bufio.NewReader(strings.NewReader(test))
+	for i, e := range expected {
+		got, err := readResponse(r)
+		if err != nil {
+			t.Fatalf("response %d could not be read. got=%s", i, err)
+		}
+
+		if got != e {
+			t.Fatalf("response %d is incorrect. want=%q got=%q", i, e, got)
+		}
+	}
+
+	_, err := readResponse(r)
+	if err == nil {
+		t.Fatal("reading past the end of the solver output did not return an error")
+	}
+}
@@ -0,0 +1,279 @@
+package execute
+
+import (
+	"fault/util"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Solvers don't agree on much beyond the core of SMTLib2.
+// Each profile knows how to start its solver, which options
+// it needs before the model is loaded, how it reports a
+// verdict and how to turn its model into the define-fun
+// format SMTListener reads.
+
+type Verdict string
+
+const (
+	SAT     Verdict = "sat"
+	UNSAT   Verdict = "unsat"
+	UNKNOWN Verdict = "unknown"
+)
+
+type Solver interface {
+	Name() string
+	Command() (string, []string)
+	Options() []string
+	Verdict(response string) (Verdict, error)
+	ModelQuery(decls []*Declaration) string // empty if there's nothing to ask for
+	NormalizeModel(response string, decls []*Declaration) (string, error)
+	NormalizeValue(value string) string
+	Optimizes() bool // supports (minimize ...)
+}
+
+type Declaration struct {
+	Name string
+	Sort string
+}
+
+var declRegex = regexp.MustCompile(`\(declare-fun\s+([^\s()]+)\s+\(\)\s+([A-Za-z]+)\)`)
+
+func Declarations(smt string) []*Declaration {
+	var decls []*Declaration
+	for _, m := range declRegex.FindAllStringSubmatch(smt, -1) {
+		decls = append(decls, &Declaration{Name: m[1], Sort: m[2]})
+	}
+	return decls
+}
+
+func GenerateSolver() Solver {
+	command, _ := os.LookupEnv("SOLVERCMD")
+	if command == "" {
+		panic("No solver is loaded, missing SOLVERCMD")
+	}
+
+	// SOLVER picks the profile when it can't be
+	// guessed from the name of the command
+	name, _ := os.LookupEnv("SOLVER")
+	if name == "" {
+		name = filepath.Base(command)
+	}
+
+	var args []string
+	if a, _ := os.LookupEnv("SOLVERARG"); a != "" {
+		args = strings.Fields(a)
+	}
+
+	return NewSolver(name, command, args)
+}
+
+func NewSolver(name string, command string, args []string) Solver {
+	switch strings.ToLower(name) {
+	case "cvc5":
+		return NewCVC5(command, args)
+	case "yices2", "yices", "yices-smt2":
+		return NewYices2(command, args)
+	default: // z3 or anything that speaks like it
+		return NewZ3(command, args)
+	}
+}
+
+type profile struct {
+	name      string
+	command   string
+	arguments []string
+	options   []string
+}
+
+func newProfile(name string, command string, args []string, defaultCmd string, defaultArgs []string, options []string) *profile {
+	if command == "" {
+		command = defaultCmd
+	}
+	if args == nil {
+		args = defaultArgs
+	}
+	return &profile{
+		name:      name,
+		command:   command,
+		arguments: args,
+		options:   options,
+	}
+}
+
+func (p *profile) Name() string {
+	return p.name
+}
+
+func (p *profile) Command() (string, []string) {
+	return p.command, p.arguments
+}
+
+func (p *profile) Options() []string {
+	return p.options
+}
+
+func (p *profile) Verdict(response string) (Verdict, error) {
+	switch strings.TrimSpace(response) {
+	case "sat":
+		return SAT, nil
+	case "unsat":
+		return UNSAT, nil
+	case "unknown":
+		return UNKNOWN, nil
+	default:
+		return "", fmt.Errorf("unexpected response from %s: %s", p.name, response)
+	}
+}
+
+func (p *profile) ModelQuery(decls []*Declaration) string {
+	return "(get-model)"
+}
+
+func (p *profile) NormalizeModel(response string, decls []*Declaration) (string, error) {
+	return cleanExtraOutputs(response), nil
+}
+
+func (p *profile) NormalizeValue(value string) string {
+	return value
+}
+
+func (p *profile) Optimizes() bool {
+	return false
+}
+
+type Z3 struct {
+	*profile
+}
+
+func NewZ3(command string, args []string) *Z3 {
+	return &Z3{newProfile("z3", command, args,
+		"z3", []string{"-in"},
+		[]string{"(set-option :produce-models true)"})}
+}
+
+func (z *Z3) Optimizes() bool {
+	return true
+}
+
+type CVC5 struct {
+	*profile
+}
+
+func NewCVC5(command string, args []string) *CVC5 {
+	return &CVC5{newProfile("cvc5", command, args,
+		"cvc5", []string{"--lang=smt2", "--incremental"},
+		[]string{"(set-option :produce-models true)",
+			"(set-option :incremental true)"})}
+}
+
+func (c *CVC5) Verdict(response string) (Verdict, error) {
+	// cvc5 sometimes explains itself (ie "unknown (INCOMPLETE)")
+	fields := strings.Fields(response)
+	if len(fields) > 1 {
+		return c.profile.Verdict(fields[0])
+	}
+	return c.profile.Verdict(response)
+}
+
+type Yices2 struct {
+	*profile
+}
+
+func NewYices2(command string, args []string) *Yices2 {
+	return &Yices2{newProfile("yices2", command, args,
+		"yices-smt2", []string{"--incremental"},
+		[]string{"(set-option :produce-models true)"})}
+}
+
+func (y *Yices2) ModelQuery(decls []*Declaration) string {
+	// yices prints its models as a list of (= x 1/2), get-value
+	// returns one s-expression that's easier to line up with
+	// the declarations
+	var names []string
+	for _, d := range decls {
+		names = append(names, d.Name)
+	}
+	if len(names) == 0 { // yices rejects (get-value ())
+		return ""
+	}
+	return fmt.Sprintf("(get-value (%s))", strings.Join(names, " "))
+}
+
+func (y *Yices2) NormalizeModel(response string, decls []*Declaration) (string, error) {
+	sorts := make(map[string]string)
+	for _, d := range decls {
+		sorts[d.Name] = d.Sort
+	}
+
+	pairs := util.SexprList(response)
+	if pairs == nil {
+		return "", fmt.Errorf("malformed model from yices2: %s", response)
+	}
+
+	var model strings.Builder
+	model.WriteString("(model\n")
+	for _, p := range pairs {
+		parts := util.SexprList(p)
+		if len(parts) != 2 {
+			return "", fmt.Errorf("malformed value from yices2: %s", p)
+		}
+
+		sort, ok := sorts[parts[0]]
+		if !ok {
+			return "", fmt.Errorf("yices2 returned an undeclared variable %s", parts[0])
+		}
+
+		value, err := yicesValue(parts[1])
+		if err != nil {
+			return "", err
+		}
+		model.WriteString(fmt.Sprintf("  (define-fun %s () %s %s)\n", parts[0], sort, value))
+	}
+	model.WriteString(")")
+	return model.String(), nil
+}
+
+func (y *Yices2) NormalizeValue(value string) string {
+	v, err := yicesValue(value)
+	if err != nil {
+		return value
+	}
+	return v
+}
+
+var yicesNumber = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+func yicesValue(v string) (string, error) {
+	// yices writes rationals as -1/2, SMTListener expects
+	// (- (/ 1.0 2.0)) the way z3 writes them. The digits
+	// are kept as they are so no precision is lost.
+	if v == "true" || v == "false" {
+		return v, nil
+	}
+
+	neg := strings.HasPrefix(v, "-")
+	v = strings.TrimPrefix(v, "-")
+
+	if strings.Contains(v, "/") {
+		r := strings.SplitN(v, "/", 2)
+		if !yicesNumber.MatchString(r[0]) || !yicesNumber.MatchString(r[1]) {
+			return "", fmt.Errorf("malformed value from yices2: %s", v)
+		}
+		v = fmt.Sprintf("(/ %s %s)", yicesDecimal(r[0]), yicesDecimal(r[1]))
+	}
+
+	if neg {
+		return fmt.Sprintf("(- %s)", v), nil
+	}
+	return v, nil
+}
+
+func yicesDecimal(n string) string {
+	if strings.Contains(n, ".") {
+		return n
+	}
+	return n + ".0"
+}
@@ -0,0 +1,117 @@
+package execute
+
+import (
+	"testing"
+)
+
+func TestNewSolver(t *testing.T) {
+	tests := map[string]string{
+		"z3":         "z3",
+		"cvc5":       "cvc5",
+		"yices-smt2": "yices2",
+		"yices2":     "yices2",
+		"other":      "z3",
+	}
+
+	for name, expected := range tests {
+		s := NewSolver(name, "", nil)
+		if s.Name() != expected {
+			t.Fatalf("wrong profile for %s. want=%s got=%s", name, expected, s.Name())
+		}
+	}
+
+	cmd, args := NewSolver("cvc5", "/usr/local/bin/cvc5", []string{"--lang=smt2"}).Command()
+	if cmd != "/usr/local/bin/cvc5" || len(args) != 1 || args[0] != "--lang=smt2" {
+		t.Fatalf("solver command not overridden. got=%s %s", cmd, args)
+	}
+}
+
+func TestVerdict(t *testing.T) {
+	tests := []struct {
+		solver   Solver
+		response string
+		expected Verdict
+	}{
+		{NewZ3("", nil), "sat", SAT},
+		{NewZ3("", nil), "unsat", UNSAT},
+		{NewZ3("", nil), "unknown", UNKNOWN},
+		{NewCVC5("", nil), "unknown (INCOMPLETE)", UNKNOWN},
+		{NewYices2("", nil), "unsat", UNSAT},
+	}
+
+	for _, test := range tests {
+		v, err := test.solver.Verdict(test.response)
+		if err != nil {
+			t.Fatalf("%s failed to read verdict %s. got=%s", test.solver.Name(), test.response, err)
+		}
+		if v != test.expected {
+			t.Fatalf("%s returned the wrong verdict. want=%s got=%s", test.solver.Name(), test.expected, v)
+		}
+	}
+
+	_, err := NewZ3("", nil).Verdict("(error \"bad\")")
+	if err == nil {
+		t.Fatal("an unexpected response did not return an error")
+	}
+}
+
+func TestDeclarations(t *testing.T) {
+	test := `(set-logic QF_NRA)
+	(declare-fun imports_fl3_vault_value_0 () Real)
+	(declare-fun imports_fl3_vault_open_0 () Bool)
+	(assert (= imports_fl3_vault_value_0 30.0))`
+
+	decls := Declarations(test)
+	if len(decls) != 2 {
+		t.Fatalf("wrong number of declarations. want=2 got=%d", len(decls))
+	}
+
+	if decls[0].Name != "imports_fl3_vault_value_0" || decls[0].Sort != "Real" {
+		t.Fatalf("declaration parsed incorrectly. got=%s %s", decls[0].Name, decls[0].Sort)
+	}
+
+	if decls[1].Name != "imports_fl3_vault_open_0" || decls[1].Sort != "Bool" {
+		t.Fatalf("declaration parsed incorrectly. got=%s %s", decls[1].Name, decls[1].Sort)
+	}
+}
+
+func TestYicesModel(t *testing.T) {
+	decls := []*Declaration{
+		{Name: "imports_fl3_vault_value_0", Sort: "Real"},
+		{Name: "imports_fl3_vault_value_1", Sort: "Real"},
+		{Name: "imports_fl3_vault_open_0", Sort: "Bool"},
+	}
+
+	y := NewYices2("", nil)
+	query := y.ModelQuery(decls)
+	if query != "(get-value (imports_fl3_vault_value_0 imports_fl3_vault_value_1 imports_fl3_vault_open_0))" {
+		t.Fatalf("yices2 model query is incorrect. got=%s", query)
+	}
+
+	model, err := y.NormalizeModel("((imports_fl3_vault_value_0 -1/2) (imports_fl3_vault_value_1 30) (imports_fl3_vault_open_0 true))", decls)
+	if err != nil {
+		t.Fatalf("yices2 model could not be normalized. got=%s", err)
+	}
+
+	response := prepTestParser(model)
+	v, ok := response["imports_fl3_vault_value"].(*FloatTrace)
+	if !ok {
+		t.Fatalf("normalized yices2 model could not be parsed. got=%s", model)
+	}
+
+	if v.results[0] != -0.5 || v.results[1] != 30.0 {
+		t.Fatalf("normalized yices2 model has the wrong values. got=%v", v.results)
+	}
+
+	if _, ok := response["imports_fl3_vault_open"].(*BoolTrace); !ok {
+		t.Fatalf("normalized yices2 model is missing a bool. got=%s", model)
+	}
+	// Digits past a float64's precision are kept
+	if v := y.NormalizeValue("-12345678901234567891/3"); v != "(- (/ 12345678901234567891.0 3.0))" {
+		t.Fatalf("yices2 rational lost precision. got=%s", v)
+	}
+
+	if query := y.ModelQuery(nil); query != "" {
+		t.Fatalf("yices2 model query sent with nothing declared. got=%s", query)
+	}
+}
@@ -0,0 +1,145 @@
+package execute
+
+import (
+	"fault/util"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// A failure scenario in the terms of the spec: one row per
+// variable, one column per round of the run block. When a
+// variable changes more than once in a round (parallel
+// steps) the earlier values get sub-columns. The last column
+// of each round is the value at the end of the round.
+
+type roundColumns struct {
+	rounds []int // in order
+	steps  map[int]int
+}
+
+func (mc *ModelChecker) Table(w io.Writer, results map[string]Scenario) {
+	var names []string
+	for k := range results {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	cells := make(map[string]map[int][]string)
+	cols := &roundColumns{steps: make(map[int]int)}
+	for _, k := range names {
+		cells[k] = mc.roundCells(k, deadBranches(k, results[k], mc.forks))
+		for r, c := range cells[k] {
+			if _, ok := cols.steps[r]; !ok {
+				cols.rounds = append(cols.rounds, r)
+			}
+			if len(c) > cols.steps[r] {
+				cols.steps[r] = len(c)
+			}
+		}
+	}
+	sort.Ints(cols.rounds)
+
+	table := tablewriter.NewWriter(w)
+	table.SetAutoFormatHeaders(false)
+	table.SetAutoWrapText(false)
+	header := cols.header(mc.specRound)
+	align := []int{tablewriter.ALIGN_LEFT}
+	for range header[1:] {
+		align = append(align, tablewriter.ALIGN_RIGHT)
+	}
+	table.SetColumnAlignment(align)
+	table.SetHeader(header)
+
+	for _, k := range names {
+		name := util.SpecName(k)
+		if r, ok := mc.Ranges[k]; ok {
+			name = fmt.Sprintf("%s %s", name, formatRange(r))
+		}
+		table.Append(append([]string{name}, cols.row(cells[k])...))
+	}
+	table.Render()
+}
+
+func (mc *ModelChecker) roundCells(k string, v Scenario) map[int][]string {
+	// Values of the variable in each round, in the
+	// order they were set
+	values := traceValues(v)
+	round := make(map[int16]int)
+	for _, s := range mc.VarRounds[k] {
+		round[int16(s[0])] = s[1]
+	}
+
+	cells := make(map[int][]string)
+	for _, i := range traceIndexes(v) {
+		r, ok := round[i]
+		if !ok { // Not a state the generator placed
+			continue
+		}
+		cells[r] = append(cells[r], values[i])
+	}
+	return cells
+}
+
+func (c *roundColumns) header(specRound func(int) int) []string {
+	h := []string{"VARIABLE"}
+	for _, r := range c.rounds {
+		for s := 1; s < c.steps[r]; s++ {
+			h = append(h, fmt.Sprintf("%d.%d", specRound(r), s))
+		}
+		h = append(h, fmt.Sprintf("%d", specRound(r)))
+	}
+	return h
+}
+
+func (c *roundColumns) row(cells map[int][]string) []string {
+	// The end of the round lines up in the last column,
+	// a variable that didn't change there keeps its value
+	var row []string
+	var last string
+	for _, r := range c.rounds {
+		values := cells[r]
+		for s := 1; s < c.steps[r]; s++ {
+			if i := len(values) - c.steps[r] + s - 1; i >= 0 {
+				row = append(row, values[i])
+			} else {
+				row = append(row, "")
+			}
+		}
+
+		if len(values) > 0 {
+			last = values[len(values)-1]
+		}
+		row = append(row, last)
+	}
+	return row
+}
+
+func traceValues(v Scenario) map[int16]string {
+	values := make(map[int16]string)
+	switch s := v.(type) {
+	case *FloatTrace:
+		for i, n := range s.Get() {
+			values[i] = withWeight(strconv.FormatFloat(n, 'f', -1, 64), i, s.GetWeights())
+		}
+	case *IntTrace:
+		for i, n := range s.Get() {
+			values[i] = withWeight(strconv.FormatInt(n, 10), i, s.GetWeights())
+		}
+	case *BoolTrace:
+		for i, n := range s.Get() {
+			values[i] = withWeight(strconv.FormatBool(n), i, s.GetWeights())
+		}
+	}
+	return values
+}
+
+func withWeight(value string, i int16, weights map[int16]float64) string {
+	if w, ok := weights[i]; ok {
+		return fmt.Sprintf("%s (%g)", value, w)
+	}
+	return value
+}
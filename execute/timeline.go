@@ -0,0 +1,181 @@
+package execute
+
+import (
+	"bytes"
+	"fault/util"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// The state chart of a system scenario. Instead of a boolean
+// per state, each component gets the state it was in at the
+// end of every round. A component that moved between rounds
+// took an advance, those are listed as transitions.
+
+type timeline struct {
+	rounds     []int    // spec rounds, in order
+	components []string // spec names
+	states     map[string][]string
+}
+
+type stateChange struct {
+	round     int // the spec round it was in the new state
+	component string
+	from      string
+	to        string
+}
+
+func (s *stateChange) String() string {
+	return fmt.Sprintf("round %d: %s.%s -> %s.%s", s.round, s.component, s.from, s.component, s.to)
+}
+
+func (mc *ModelChecker) isState(k string) bool {
+	for _, c := range mc.Components {
+		for _, s := range c[1:] {
+			if s == k {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (mc *ModelChecker) timeline(results map[string]Scenario) *timeline {
+	t := &timeline{states: make(map[string][]string)}
+	ends := make(map[string]map[int]string)
+	seen := make(map[int]bool)
+	for _, c := range mc.Components {
+		for _, s := range c[1:] {
+			v, ok := results[s]
+			if !ok {
+				continue
+			}
+
+			ends[s] = make(map[int]string)
+			for r, values := range mc.roundCells(s, deadBranches(s, v, mc.forks)) {
+				ends[s][r] = values[len(values)-1]
+				if !seen[r] {
+					seen[r] = true
+					t.rounds = append(t.rounds, r)
+				}
+			}
+		}
+	}
+	sort.Ints(t.rounds)
+
+	for _, c := range mc.Components {
+		if len(c) < 2 { // Not a component with states
+			continue
+		}
+
+		name := util.SpecName(c[0])
+		t.components = append(t.components, name)
+		last := make(map[string]string) // a state that didn't change keeps its value
+		for _, r := range t.rounds {
+			var active []string
+			for _, s := range c[1:] {
+				if v, ok := ends[s][r]; ok {
+					last[s] = v
+				}
+				if last[s] == "true" {
+					active = append(active, strings.TrimPrefix(util.SpecName(s), name+"."))
+				}
+			}
+
+			if len(active) == 0 {
+				t.states[name] = append(t.states[name], "-")
+				continue
+			}
+			t.states[name] = append(t.states[name], strings.Join(active, ", "))
+		}
+	}
+
+	for i, r := range t.rounds {
+		t.rounds[i] = mc.specRound(r)
+	}
+	return t
+}
+
+func (t *timeline) changes() []*stateChange {
+	var changes []*stateChange
+	for i := 1; i < len(t.rounds); i++ {
+		for _, c := range t.components {
+			from, to := t.states[c][i-1], t.states[c][i]
+			if from == to || from == "-" || to == "-" {
+				continue
+			}
+			changes = append(changes, &stateChange{round: t.rounds[i], component: c, from: from, to: to})
+		}
+	}
+	return changes
+}
+
+func (mc *ModelChecker) Timeline(w io.Writer, results map[string]Scenario) {
+	t := mc.timeline(results)
+	if len(t.components) == 0 {
+		return
+	}
+
+	table := tablewriter.NewWriter(w)
+	table.SetAutoFormatHeaders(false)
+	table.SetAutoWrapText(false)
+	header := []string{"COMPONENT"}
+	for _, r := range t.rounds {
+		header = append(header, fmt.Sprintf("%d", r))
+	}
+	table.SetHeader(header)
+
+	for _, c := range t.components {
+		table.Append(append([]string{c}, t.states[c]...))
+	}
+	table.Render()
+
+	if changes := t.changes(); len(changes) > 0 {
+		fmt.Fprintln(w, "\ntransitions")
+		for _, s := range changes {
+			fmt.Fprintln(w, s.String())
+		}
+	}
+}
+
+func (mc *ModelChecker) StateChart(results map[string]Scenario) {
+	if chart := mc.stateChart(results); chart != "" {
+		fmt.Println(chart)
+	}
+}
+
+func (mc *ModelChecker) stateChart(results map[string]Scenario) string {
+	// The timeline as a Mermaid gantt chart, one section
+	// per component and a milestone for every transition
+	t := mc.timeline(results)
+	if len(t.components) == 0 {
+		return ""
+	}
+
+	var out bytes.Buffer
+	out.WriteString("gantt\n\tdateFormat X\n\taxisFormat %s\n")
+	changes := t.changes()
+	for _, c := range t.components {
+		out.WriteString(fmt.Sprintf("\tsection %s\n", c))
+		start := 0
+		for i := 1; i <= len(t.rounds); i++ {
+			if i < len(t.rounds) && t.states[c][i] == t.states[c][start] {
+				continue
+			}
+			// A state runs until the next round starts
+			out.WriteString(fmt.Sprintf("\t%s :%d, %d\n", t.states[c][start], t.rounds[start], t.rounds[i-1]+1))
+			start = i
+		}
+
+		for _, s := range changes {
+			if s.component == c {
+				out.WriteString(fmt.Sprintf("\t%s -> %s :milestone, %d, %d\n", s.from, s.to, s.round, s.round))
+			}
+		}
+	}
+	return out.String()
+}
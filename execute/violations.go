@@ -0,0 +1,112 @@
+package execute
+
+import (
+	"context"
+	"errors"
+	"fault/smt/properties"
+	"fault/util"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Which asserts did a scenario break? Each clause of each
+// assert is evaluated against the model while it's still
+// loaded in the solver, the first one that holds is the
+// round where the assert was violated.
+
+type Violation struct {
+	Name   string `json:"name"` // :named in the SMT
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	Round  int    `json:"round"` // counting from 1, 0 before the run block
+	Label  string `json:"label,omitempty"`
+}
+
+func (v *Violation) String(file string) string {
+	name := assertName(file, v.Line)
+	if v.Label != "" {
+		name = v.Label
+	}
+	if v.Round == 0 {
+		return fmt.Sprintf("%s violated before the run block", name)
+	}
+	return fmt.Sprintf("%s violated in round %d", name, v.Round)
+}
+
+func propertyName(file string, p *properties.Property) string {
+	if p.Label != "" { // Added by -auto-props, no line in the spec
+		return p.Label
+	}
+	return assertName(file, p.Line())
+}
+
+func assertName(file string, line int) string {
+	return "assert at " + specLine(file, line)
+}
+
+func specLine(file string, line int) string {
+	if file == "" {
+		return fmt.Sprintf("line %d", line)
+	}
+	return fmt.Sprintf("%s:%d", filepath.Base(file), line)
+}
+
+func (mc *ModelChecker) specRound(r int) int {
+	// The generator's rounds start with the setup before
+	// the run block (constants, start states) only when
+	// there is any, rounds in the spec count from 1
+	if r >= 0 && r < len(mc.RunRounds) {
+		return mc.RunRounds[r]
+	}
+	return r + 1
+}
+
+func (mc *ModelChecker) violated(ctx context.Context) ([]*Violation, error) {
+	var violations []*Violation
+	for _, p := range mc.Properties {
+		if p.Assume || len(p.Clauses) == 0 {
+			continue
+		}
+
+		clause, err := mc.firstTrue(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		if clause == -1 {
+			continue
+		}
+
+		v := &Violation{Name: p.Name, Line: p.Line(), Round: mc.specRound(p.Round(clause)), Label: p.Label}
+		if len(p.Position) > 1 {
+			v.Column = p.Position[1]
+		}
+		violations = append(violations, v)
+	}
+	return violations, nil
+}
+
+func (mc *ModelChecker) firstTrue(ctx context.Context, p *properties.Property) (int, error) {
+	results, err := mc.query(ctx, fmt.Sprintf("(get-value (%s))", strings.Join(p.Clauses, " ")))
+	if err != nil {
+		return -1, err
+	}
+
+	for i, pair := range util.SexprList(results) {
+		parts := util.SexprList(pair)
+		if len(parts) < 2 {
+			return -1, errors.New("malformed value from solver: " + pair)
+		}
+
+		if parts[len(parts)-1] == "true" {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+func (mc *ModelChecker) Violations(results map[string]Scenario) []*Violation {
+	// Violations found when the scenario was solved
+	return mc.violations[scenarioKey(mc.keptValues(results))]
+}
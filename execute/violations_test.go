@@ -0,0 +1,151 @@
+package execute
+
+import (
+	"context"
+	"fault/smt/properties"
+	"fault/smt/variables"
+	"testing"
+)
+
+func TestViolations(t *testing.T) {
+	// x breaks the assert in its second state only
+	replies := map[string]string{
+		"check-sat":      "echo sat",
+		"get-model":      `echo "((define-fun x_0 () Real 10.0) (define-fun x_1 () Real 0.0))"`,
+		"get-value ((<=": `echo "(((<= x_0 0) false) ((<= x_1 0) true))"`,
+		"get-value":      `echo "((x_0 10.0) (x_1 0.0))"`,
+	}
+	model := NewModelCheckerWithSolver(fakeSolver(replies))
+	model.LoadModel("(declare-fun x_0 () Real)(declare-fun x_1 () Real)", make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	model.Properties = []*properties.Property{
+		{
+			Name:     "assert_0",
+			Position: []int{22, 1, 22, 20},
+			Rule:     "(or (<= x_0 0) (<= x_1 0))",
+			Clauses:  []string{"(<= x_0 0)", "(<= x_1 0)"},
+			Rounds:   []int{0, 2},
+		},
+		{
+			Name:     "assume_0",
+			Position: []int{23, 1, 23, 20},
+			Assume:   true,
+			Rule:     "(> x_0 5)",
+			Clauses:  []string{"(> x_0 5)"},
+			Rounds:   []int{0},
+		},
+	}
+	model.File = "specs/bathtub.fspec"
+	defer model.Close()
+
+	found, err := model.Enumerate(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("enumerating scenarios failed. got=%s", err)
+	}
+
+	if len(found) != 1 {
+		t.Fatalf("wrong number of scenarios found. want=1 got=%d", len(found))
+	}
+
+	v := model.Violations(model.Filter(found[0]))
+	if len(v) != 1 {
+		t.Fatalf("wrong number of violations. want=1 got=%d", len(v))
+	}
+
+	if v[0].Name != "assert_0" || v[0].Line != 22 || v[0].Round != 3 {
+		t.Fatalf("violation is incorrect. got=%+v", v[0])
+	}
+
+	if v[0].String(model.File) != "assert at bathtub.fspec:22 violated in round 3" {
+		t.Fatalf("violation message is incorrect. got=%s", v[0].String(model.File))
+	}
+
+	v[0].Label = "t.foo.value stays natural"
+	if v[0].String(model.File) != "t.foo.value stays natural violated in round 3" {
+		t.Fatalf("labeled violation message is incorrect. got=%s", v[0].String(model.File))
+	}
+
+	report := model.Summary(found[0]).Scenarios[0]
+	if len(report.Violations) != 1 {
+		t.Fatalf("violations missing from report. got=%v", report.Violations)
+	}
+}
+
+func TestViolationsConstants(t *testing.T) {
+	// The constant is set before the run block, the
+	// value drops below 7 in the second round
+	test := `spec test1;
+
+	const a = 2;
+
+	def amount = stock{
+		value: 10,
+	};
+
+	def test = flow{
+		foo: new amount,
+		bar: func{
+			foo.value -> a;
+		},
+	};
+
+	assert amount.value > 7;
+
+	for 2 run {
+		t = new test;
+		t.bar;
+	};
+	`
+	replies := map[string]string{
+		"check-sat":      "echo sat",
+		"get-model":      `echo "((define-fun test1_a_0 () Real 2.0) (define-fun test1_t_foo_value_0 () Real 10.0) (define-fun test1_t_foo_value_1 () Real 8.0) (define-fun test1_t_foo_value_2 () Real 6.0))"`,
+		"get-value ((<=": `echo "(((<= test1_t_foo_value_0 7) false) ((<= test1_t_foo_value_1 7) false) ((<= test1_t_foo_value_2 7) true))"`,
+		"get-value":      `echo "((test1_a_0 2.0) (test1_t_foo_value_0 10.0) (test1_t_foo_value_1 8.0) (test1_t_foo_value_2 6.0))"`,
+	}
+	model := prepSpec(test, fakeSolver(replies))
+	defer model.Close()
+
+	found, err := model.Enumerate(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("enumerating scenarios failed. got=%s", err)
+	}
+
+	if len(found) != 1 {
+		t.Fatalf("wrong number of scenarios found. want=1 got=%d", len(found))
+	}
+
+	v := model.Violations(model.Filter(found[0]))
+	if len(v) != 1 || v[0].Round != 2 {
+		t.Fatalf("violation is incorrect. got=%+v", v)
+	}
+}
+
+func TestSMTViolations(t *testing.T) {
+	model := prepSolverTest(t, `(declare-fun x_0 () Real)(declare-fun x_1 () Real)
+(assert (= x_0 10.0))
+(assert (= x_1 (- x_0 10.0)))
+(assert (! (or (<= x_0 0.0) (<= x_1 0.0)) :named assert_0))`)
+	model.Properties = []*properties.Property{
+		{
+			Name:     "assert_0",
+			Position: []int{22, 1, 22, 20},
+			Rule:     "(or (<= x_0 0.0) (<= x_1 0.0))",
+			Clauses:  []string{"(<= x_0 0.0)", "(<= x_1 0.0)"},
+			Rounds:   []int{0, 1},
+		},
+	}
+	defer model.Close()
+
+	found, err := model.Enumerate(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("enumerating scenarios failed. got=%s", err)
+	}
+
+	if len(found) != 1 {
+		t.Fatalf("wrong number of scenarios found. want=1 got=%d", len(found))
+	}
+
+	v := model.Violations(model.Filter(found[0]))
+	if len(v) != 1 || v[0].Name != "assert_0" || v[0].Round != 2 {
+		t.Fatalf("violation is incorrect. got=%+v", v)
+	}
+}
@@ -12,12 +12,28 @@ Help()
    echo "-f [filepath]     spec file."
    echo "-h                print this help guide."
    echo "-m [mode]         stop compiler at certain milestones: ast,"
-   echo "                   ir, smt, or check (default: check)"
+   echo "                   ir, smt, check, optimize, properties"
+   echo "                   incremental, prove or deadlock"
+   echo "                   (default: check)"
    echo
-   echo "-c [completeness] check that the system spec is complete"
+   echo "-c [completeness] check that every state of the system can"
+   echo "                   be entered and every transition can fire"
    echo "                   (default: false)"
+   echo "-a [auto-props]   add asserts for natural values, zero"
+   echo "                   divisors and one active state per"
+   echo "                   component (default: false)"
+   echo "-g [progress]     states a system must keep visiting in"
+   echo "                   deadlock mode (ie a.done,b.sent)"
    echo 
    echo "-i [input]        format of the input file (default: fspec)"
+   echo "-o [output]       format of the results: text or json"
+   echo "                   (default: text)"
+   echo "-s [scenarios]    number of distinct failure scenarios"
+   echo "                   to look for (default: 1)"
+   echo "-p [probability]  skip scenarios less likely than this"
+   echo "                   (default: none)"
+   echo "-t [timeout]      stop the solver after this long (ie 30s)"
+   echo "                   and report unknown (default: none)"
    echo "-V                print software version and exit."
    echo
 }
@@ -50,13 +66,19 @@ path=${path#"/"}
 ################################################################################
 
 
-while getopts f:m:i:c:hV flag
+while getopts f:m:i:c:a:g:o:s:p:t:hV flag
 do
     case "${flag}" in
         f) file=${OPTARG};;
         m) mode=${OPTARG};;
         i) input=${OPTARG};;
         c) reach=${OPTARG};;
+        a) autoprops=${OPTARG};;
+        g) progress=${OPTARG};;
+        o) output=${OPTARG};;
+        s) scenarios=${OPTARG};;
+        p) probability=${OPTARG};;
+        t) timeout=${OPTARG};;
         h) Help
            exit;;
         V) Version
@@ -72,9 +94,15 @@ else
     mode=${mode/=/} 
     input=${input/=/}
     reach=${reach/=/}
+    autoprops=${autoprops/=/}
+    progress=${progress/=/}
+    output=${output/=/}
+    scenarios=${scenarios/=/}
+    probability=${probability/=/}
+    timeout=${timeout/=/}
     file=${file/=/}
     
     filepath="${path}/${file}"
 
-    docker run -v $home:/host:ro fault-lang/fault-z3 -m=$mode -f=$filepath -i=$input -c=$reach
+    docker run -v $home:/host:ro fault-lang/fault-z3 -m=$mode -f=$filepath -i=$input -c=$reach -auto-props=$autoprops -progress=$progress -o=$output -scenarios=$scenarios -min-probability=$probability -timeout=$timeout
 fi
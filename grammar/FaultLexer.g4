@@ -13,6 +13,7 @@ lexer grammar FaultLexer;
 
 // Keywords
 ALL: 'all';
+ANY: 'any';
 ASSERT: 'assert';
 ASSUME: 'assume';
 CLOCK: 'now';
@@ -172,16 +172,25 @@ accessHistory
     ;
 
 assertion
-    : 'assert' invariant temporal? eos
+    : 'assert' quantifier? invariant temporal? eos
     ;
 
 assumption
-    : 'assume' invariant temporal? eos
+    : 'assume' quantifier? invariant temporal? eos
     ;
 
+quantifier
+    : 'all'
+    | 'any'
+    ;
+
+// next, within, between and until are names rather than
+// keywords so specs can keep using them as identifiers
 temporal
-    : ('eventually' | 'always' | 'eventually-always' )
+    : ('eventually' | 'always' | 'eventually-always')
     | ('nmt' | 'nft') integer
+    | IDENT (integer (',' integer)?)?
+    | IDENT expression
     ;
 
 invariant
@@ -253,6 +262,11 @@ faultType
     | TY_UNKNOWN
     ;
 
+// uncertain(mean, sigma) is a normal distribution, other
+// distributions are named as the first operand, ie
+// uncertain(uniform, 0, 10) or uncertain(bernoulli, 0.2).
+// unknown(min, max) and unknown(min, max, step) bound
+// an unknown value
 solvable
     : faultType '(' operand? (',' operand)* ')' 
     ;
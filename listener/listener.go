@@ -28,7 +28,9 @@ type FaultListener struct {
 	Path                 string // The location of the main spec
 	testing              bool   // bypass imports when we're running unit tests
 	Uncertains           map[string][]float64
+	Distributions        map[string]*ast.Uncertain
 	Unknowns             []string
+	Ranges               map[string][]float64 // bounds on unknowns
 	StructsPropertyOrder map[string][]string
 }
 
@@ -38,6 +40,8 @@ func NewListener(path string, testing bool, skipRun bool) *FaultListener {
 		testing:              testing,
 		skipRun:              skipRun,
 		Uncertains:           make(map[string][]float64),
+		Distributions:        make(map[string]*ast.Uncertain),
+		Ranges:               make(map[string][]float64),
 		StructsPropertyOrder: make(map[string][]string),
 	}
 }
@@ -228,8 +232,12 @@ func (l *FaultListener) ExitConstSpec(c *parser.ConstSpecContext) {
 			inst.Name = ident
 			val = inst
 			l.Unknowns = append(l.Unknowns, strings.Join([]string{l.currSpec, ident.Value}, "_"))
+			if inst.Range != nil {
+				l.Ranges[strings.Join([]string{l.currSpec, ident.Value}, "_")] = inst.Range
+			}
 		case *ast.Uncertain:
 			l.Uncertains[strings.Join([]string{l.currSpec, ident.Value}, "_")] = []float64{inst.Mean, inst.Sigma}
+			l.Distributions[strings.Join([]string{l.currSpec, ident.Value}, "_")] = inst
 		}
 		var temp []interface{}
 		temp = append(temp, &ast.ConstantStatement{
@@ -573,8 +581,12 @@ func (l *FaultListener) ExitMiscAssign(c *parser.MiscAssignContext) {
 				right = inst
 			}
 			l.Unknowns = append(l.Unknowns, strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_"))
+			if inst.Range != nil {
+				l.Ranges[strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_")] = inst.Range
+			}
 		case *ast.Uncertain:
 			l.Uncertains[strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_")] = []float64{inst.Mean, inst.Sigma}
+			l.Distributions[strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_")] = inst
 		}
 
 		assign = &ast.InfixExpression{
@@ -870,33 +882,87 @@ func (l *FaultListener) ExitSolvable(c *parser.SolvableContext) {
 	case "uncertain":
 		token := util.GenerateToken("UNCERTAIN", "UNCERTAIN", c.GetStart(), c.GetStop())
 
-		v1 := l.pop()
-		sigma, err := l.intOrFloatOk(v1)
-		if err != nil {
-			panic(fmt.Sprintf("Invalid value for sigma of type uncertain. got=%T at: line %d col %d", v1, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		values := make([]interface{}, len(c.AllOperand()))
+		for i := len(values) - 1; i >= 0; i-- {
+			values[i] = l.pop()
+		}
+
+		// uncertain(mean, sigma) or uncertain(distribution, params...)
+		dist := "normal"
+		if len(values) > 0 {
+			if ident, ok := values[0].(*ast.Identifier); ok {
+				dist = strings.ToLower(ident.Value)
+				values = values[1:]
+			}
 		}
 
-		v2 := l.pop()
-		mean, err := l.intOrFloatOk(v2)
+		names, ok := util.DistributionParams[dist]
+		if !ok {
+			panic(fmt.Sprintf("Invalid distribution %s for type uncertain at: line %d col %d", dist, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		}
+
+		if len(values) != len(names) {
+			panic(fmt.Sprintf("Invalid number of values for %s distribution of type uncertain. want=%d got=%d at: line %d col %d", dist, len(names), len(values), c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		}
+
+		var params []float64
+		for i, v := range values {
+			p, err := l.intOrFloatOk(v)
+			if err != nil {
+				panic(fmt.Sprintf("Invalid value for %s of type uncertain. got=%T at: line %d col %d", strings.ToLower(names[i]), v, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+			}
+			params = append(params, p)
+		}
+
+		d, err := util.NewDistribution(dist, params)
 		if err != nil {
-			panic(fmt.Sprintf("Invalid value for mean of type uncertain. got=%T at: line %d col %d", v2, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+			panic(fmt.Sprintf("%s at: line %d col %d", err, c.GetStart().GetLine(), c.GetStart().GetColumn()))
 		}
 
 		l.push(&ast.Uncertain{
-			Token: token,
-			Mean:  mean,
-			Sigma: sigma,
+			Token:        token,
+			Mean:         d.Mean(),
+			Sigma:        d.StdDev(),
+			Distribution: dist,
+			Params:       params,
 		})
 	case "unknown":
 		token := util.GenerateToken("UNKNOWN", "UNKNOWN", c.GetStart(), c.GetStop())
 
+		values := make([]interface{}, len(c.AllOperand()))
+		for i := len(values) - 1; i >= 0; i-- {
+			values[i] = l.pop()
+		}
+
+		// unknown(), unknown(name) or with bounds unknown(min, max[, step])
 		var ident *ast.Identifier
-		if c.GetChildCount() > 3 {
-			ident, _ = l.pop().(*ast.Identifier)
+		if len(values) > 0 {
+			if n, ok := values[0].(*ast.Identifier); ok {
+				ident = n
+				values = values[1:]
+			}
+		}
+
+		var bounds []float64
+		for _, v := range values {
+			b, err := l.intOrFloatOk(v)
+			if err != nil {
+				panic(fmt.Sprintf("Invalid bound for type unknown. got=%T at: line %d col %d", v, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+			}
+			bounds = append(bounds, b)
 		}
+
+		if len(bounds) > 0 {
+			err := util.ValidateRange(bounds)
+			if err != nil {
+				panic(fmt.Sprintf("%s at: line %d col %d", err, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+			}
+		}
+
 		l.push(&ast.Unknown{
 			Token: token,
 			Name:  ident,
+			Range: bounds,
 		})
 	default:
 		log.Fatalf("Unimplemented: %s", c.FaultType().GetText())
@@ -1252,17 +1318,10 @@ func (l *FaultListener) ExitAssertion(c *parser.AssertionContext) {
 
 	var temporal string
 	var temporalFilter string
-	var temporalN int
+	var temporalN, temporalM int
+	var until *ast.InvariantClause
 	if c.Temporal() != nil {
-		if c.Temporal().GetChildCount() == 2 {
-			i := l.pop()
-			temporalRaw := c.Temporal().GetText()
-			temporal = ""
-			temporalFilter = temporalRaw[0 : len(temporalRaw)-len(i.(*ast.IntegerLiteral).String())]
-			temporalN = int(i.(*ast.IntegerLiteral).Value)
-		} else {
-			temporal = c.Temporal().GetText()
-		}
+		temporal, temporalFilter, temporalN, temporalM, until = l.temporal(c.Temporal().(*parser.TemporalContext))
 	}
 
 	expr := l.pop()
@@ -1308,7 +1367,10 @@ func (l *FaultListener) ExitAssertion(c *parser.AssertionContext) {
 		Constraint:     con,
 		Temporal:       temporal,
 		TemporalFilter: temporalFilter,
+		Quantifier:     quantifier(c.Quantifier()),
 		TemporalN:      temporalN,
+		TemporalM:      temporalM,
+		Until:          until,
 		Assume:         false,
 	})
 }
@@ -1317,17 +1379,10 @@ func (l *FaultListener) ExitAssumption(c *parser.AssumptionContext) {
 	token := util.GenerateToken("ASSUME", "assume", c.GetStart(), c.GetStop())
 	var temporal string
 	var temporalFilter string
-	var temporalN int
+	var temporalN, temporalM int
+	var until *ast.InvariantClause
 	if c.Temporal() != nil {
-		if c.Temporal().GetChildCount() == 2 {
-			i := l.pop()
-			temporalRaw := c.Temporal().GetText()
-			temporal = ""
-			temporalFilter = temporalRaw[0 : len(temporalRaw)-len(i.(*ast.IntegerLiteral).String())]
-			temporalN = int(i.(*ast.IntegerLiteral).Value)
-		} else {
-			temporal = c.Temporal().GetText()
-		}
+		temporal, temporalFilter, temporalN, temporalM, until = l.temporal(c.Temporal().(*parser.TemporalContext))
 	}
 
 	expr := l.pop()
@@ -1379,7 +1434,10 @@ func (l *FaultListener) ExitAssumption(c *parser.AssumptionContext) {
 		Constraint:     con,
 		Temporal:       temporal,
 		TemporalFilter: temporalFilter,
+		Quantifier:     quantifier(c.Quantifier()),
 		TemporalN:      temporalN,
+		TemporalM:      temporalM,
+		Until:          until,
 		Assume:         true,
 	})
 }
@@ -1393,21 +1451,29 @@ func (l *FaultListener) parseImport(spec string) *ast.Spec {
 	listener := NewListener("", false, true)
 	antlr.ParseTreeWalkerDefault.Walk(listener, p.Spec())
 
-	l.Uncertains, l.Unknowns, l.StructsPropertyOrder = mergeListeners(l, listener)
+	l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, l.StructsPropertyOrder = mergeListeners(l, listener)
 	return listener.AST
 }
 
-func mergeListeners(l1 *FaultListener, l2 *FaultListener) (map[string][]float64, []string, map[string][]string) {
+func mergeListeners(l1 *FaultListener, l2 *FaultListener) (map[string][]float64, map[string]*ast.Uncertain, []string, map[string][]float64, map[string][]string) {
 	for k, v := range l2.Uncertains {
 		l1.Uncertains[k] = v
 	}
 
+	for k, v := range l2.Distributions {
+		l1.Distributions[k] = v
+	}
+
 	l1.Unknowns = append(l1.Unknowns, l2.Unknowns...)
 
+	for k, v := range l2.Ranges {
+		l1.Ranges[k] = v
+	}
+
 	for k, v := range l2.StructsPropertyOrder {
 		l1.StructsPropertyOrder[k] = v
 	}
-	return l1.Uncertains, l1.Unknowns, l1.StructsPropertyOrder
+	return l1.Uncertains, l1.Distributions, l1.Unknowns, l1.Ranges, l1.StructsPropertyOrder
 }
 
 func (l *FaultListener) getPairs(p int, pos []int) (map[*ast.Identifier]ast.Expression, []string) {
@@ -1434,8 +1500,12 @@ func (l *FaultListener) getPairs(p int, pos []int) (map[*ast.Identifier]ast.Expr
 			inst.Name = ident
 			right = inst
 			l.Unknowns = append(l.Unknowns, strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_"))
+			if inst.Range != nil {
+				l.Ranges[strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_")] = inst.Range
+			}
 		case *ast.Uncertain:
 			l.Uncertains[strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_")] = []float64{inst.Mean, inst.Sigma}
+			l.Distributions[strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_")] = inst
 		}
 		order = append([]string{ident.Value}, order...)
 		pairs[ident] = right.(ast.Expression)
@@ -1725,3 +1795,83 @@ func (l *FaultListener) packageCallsAsRunSteps(node ast.Node) ast.Node {
 func (l *FaultListener) builtInType(b *ast.BuiltIn) string {
 	return b.Function
 }
+
+func (l *FaultListener) temporal(c *parser.TemporalContext) (string, string, int, int, *ast.InvariantClause) {
+	// eventually, always and eventually-always apply to every
+	// state, the rest filter states by a count, a window of
+	// rounds or another invariant. The window filters are plain
+	// names in the grammar so specs can still use them as
+	// identifiers, the words are checked here.
+	pos := []int{c.GetStart().GetLine(), c.GetStart().GetColumn()}
+	if c.IDENT() == nil {
+		op := c.GetChild(0).(antlr.ParseTree).GetText()
+		if c.NMT() != nil || c.NFT() != nil {
+			return "", op, l.popInteger(op, pos), 0, nil
+		}
+		return op, "", 0, 0, nil
+	}
+
+	op := c.IDENT().GetText()
+	if c.Expression() != nil {
+		if op != "until" {
+			panic(fmt.Sprintf("unknown temporal filter %s: line %d col %d", op, pos[0], pos[1]))
+		}
+		return "", op, 0, 0, untilClause(l.pop(), pos)
+	}
+
+	switch n := len(c.AllInteger()); {
+	case op == "next" && n == 0:
+		return "", op, 0, 0, nil
+	case op == "within" && n == 1:
+		w := l.popInteger(op, pos)
+		if w < 1 {
+			panic(fmt.Sprintf("within needs at least 1 round got %d: line %d col %d", w, pos[0], pos[1]))
+		}
+		return "", op, w, 0, nil
+	case op == "between" && n == 2:
+		b := l.popInteger(op, pos)
+		a := l.popInteger(op, pos)
+		if a < 1 || b < a {
+			panic(fmt.Sprintf("between window is invalid, want 1 <= start <= end got %d, %d: line %d col %d", a, b, pos[0], pos[1]))
+		}
+		return "", op, a, b, nil
+	case op == "until":
+		panic(fmt.Sprintf("until needs an invariant: line %d col %d", pos[0], pos[1]))
+	case op == "next" || op == "within" || op == "between":
+		panic(fmt.Sprintf("%s has the wrong number of rounds: line %d col %d", op, pos[0], pos[1]))
+	}
+	panic(fmt.Sprintf("unknown temporal filter %s: line %d col %d", op, pos[0], pos[1]))
+}
+
+func (l *FaultListener) popInteger(op string, pos []int) int {
+	i, ok := l.pop().(*ast.IntegerLiteral)
+	if !ok {
+		panic(fmt.Sprintf("%s needs a whole number of rounds: line %d col %d", op, pos[0], pos[1]))
+	}
+	return int(i.Value)
+}
+
+func untilClause(e interface{}, pos []int) *ast.InvariantClause {
+	switch n := e.(type) {
+	case *ast.Identifier:
+		return &ast.InvariantClause{Token: n.Token, Left: n, Operator: "==", Right: &ast.Boolean{Value: true}}
+	case *ast.ParameterCall:
+		return &ast.InvariantClause{Token: n.Token, Left: n, Operator: "==", Right: &ast.Boolean{Value: true}}
+	case *ast.PrefixExpression:
+		if n.Operator == "!" {
+			return &ast.InvariantClause{Token: n.Token, Left: n.Right, Operator: "!=", Right: &ast.Boolean{Value: true}}
+		}
+	case *ast.InfixExpression:
+		return &ast.InvariantClause{Token: n.Token, Left: n.Left, Operator: n.Operator, Right: n.Right}
+	}
+	panic(fmt.Sprintf("until needs an invariant not %T: line %d col %d", e, pos[0], pos[1]))
+}
+
+func quantifier(q parser.IQuantifierContext) string {
+	// all or any instance of a stock/flow, empty when the
+	// assertion doesn't say
+	if q == nil {
+		return ""
+	}
+	return q.GetText()
+}
@@ -2,6 +2,7 @@ package listener
 
 import (
 	"fault/ast"
+	"fmt"
 	"testing"
 )
 
@@ -1258,6 +1259,84 @@ func TestTemporalFilter(t *testing.T) {
 
 }
 
+func TestTemporalWindow(t *testing.T) {
+	tests := []struct {
+		temporal string
+		filter   string
+		n, m     int
+		until    string
+	}{
+		{"next", "next", 0, 0, ""},
+		{"within 2", "within", 2, 0, ""},
+		{"between 2, 4", "between", 2, 4, ""},
+		{"until z < 5", "until", 0, 0, "z"},
+	}
+
+	for _, tt := range tests {
+		test := fmt.Sprintf(`spec test1;
+			 assert x > y %s;
+			`, tt.temporal)
+		flags := make(map[string]bool)
+		flags["specType"] = true
+		_, spec := prepTest(test, flags)
+		assert, ok := spec.Statements[1].(*ast.AssertionStatement)
+		if !ok {
+			t.Fatalf("spec.Statements[1] is not an AssertionStatement. got=%T", spec.Statements[1])
+		}
+
+		if assert.Constraint.Left.(*ast.Identifier).Value != "x" || assert.Constraint.Operator != ">" {
+			t.Fatalf("%s assert invariant is not correct. got=%s", tt.filter, assert.Constraint.String())
+		}
+
+		if assert.TemporalFilter != tt.filter || assert.TemporalN != tt.n || assert.TemporalM != tt.m {
+			t.Fatalf("%s assert filter is not correct. got=%s %d %d", tt.filter, assert.TemporalFilter, assert.TemporalN, assert.TemporalM)
+		}
+
+		if tt.until == "" {
+			if assert.Until != nil {
+				t.Fatalf("%s assert has an until invariant. got=%s", tt.filter, assert.Until.String())
+			}
+			continue
+		}
+
+		if assert.Until == nil || assert.Until.Left.(*ast.Identifier).Value != tt.until || assert.Until.Operator != "<" {
+			t.Fatalf("until invariant is not correct. got=%v, want=%s < 5", assert.Until, tt.until)
+		}
+	}
+}
+
+func TestTemporalWords(t *testing.T) {
+	// The window filters aren't keywords
+	test := `spec test1;
+			 const next = 2;
+			 const until = 3;
+			 assert next > until within 2;
+			`
+	flags := make(map[string]bool)
+	flags["specType"] = true
+	_, spec := prepTest(test, flags)
+	if len(spec.Statements) != 4 {
+		t.Fatalf("spec.Statements does not contain 4 statements. got=%d", len(spec.Statements))
+	}
+
+	if spec.Statements[1].(*ast.ConstantStatement).Name.Value != "next" {
+		t.Fatalf("Constant identifier is not next. got=%s", spec.Statements[1].(*ast.ConstantStatement).Name.Value)
+	}
+
+	assert, ok := spec.Statements[3].(*ast.AssertionStatement)
+	if !ok {
+		t.Fatalf("spec.Statements[3] is not an AssertionStatement. got=%T", spec.Statements[3])
+	}
+
+	if assert.Constraint.Left.(*ast.Identifier).Value != "next" || assert.Constraint.Right.(*ast.Identifier).Value != "until" {
+		t.Fatalf("assert invariant is not correct. got=%s", assert.Constraint.String())
+	}
+
+	if assert.TemporalFilter != "within" || assert.TemporalN != 2 {
+		t.Fatalf("assert filter is not correct. got=%s %d, want=within 2", assert.TemporalFilter, assert.TemporalN)
+	}
+}
+
 func TestFaultAssign(t *testing.T) {
 	test := `spec test1;
 			 def foo = flow{
@@ -1524,6 +1603,91 @@ func TestDeclaredType(t *testing.T) {
 	}
 }
 
+func TestUnknownRange(t *testing.T) {
+	test := `spec test1;
+			 const a = unknown(0, 10);
+			 const b = unknown(1, 5, 2);
+			 const c = unknown();
+			`
+	flags := make(map[string]bool)
+	flags["specType"] = true
+	l, spec := prepTest(test, flags)
+	con, ok := spec.Statements[1].(*ast.ConstantStatement)
+	if !ok {
+		t.Fatalf("spec.Statements[1] is not a ConstantStatement. got=%T", spec.Statements[1])
+	}
+
+	unknown, ok := con.Value.(*ast.Unknown)
+	if !ok {
+		t.Fatalf("Constant is not an Unknown. got=%T", con.Value)
+	}
+
+	if len(unknown.Range) != 2 || unknown.Range[0] != 0 || unknown.Range[1] != 10 {
+		t.Fatalf("Unknown range is not 0, 10. got=%v", unknown.Range)
+	}
+
+	if r := l.Ranges["test1_b"]; len(r) != 3 || r[2] != 2 {
+		t.Fatalf("Unknown range with a step not indexed. got=%v", l.Ranges)
+	}
+
+	if _, ok := l.Ranges["test1_c"]; ok {
+		t.Fatalf("Unbounded unknown given a range. got=%v", l.Ranges["test1_c"])
+	}
+
+	if len(l.Unknowns) != 3 {
+		t.Fatalf("missing an unknown want=3 got=%d", len(l.Unknowns))
+	}
+}
+
+func TestDeclaredDistribution(t *testing.T) {
+	test := `spec test1;
+			 const a = uncertain(uniform, 0, 10);
+			 const b = uncertain(bernoulli, 0.2);
+			`
+	flags := make(map[string]bool)
+	flags["specType"] = true
+	l, spec := prepTest(test, flags)
+	con, ok := spec.Statements[1].(*ast.ConstantStatement)
+	if !ok {
+		t.Fatalf("spec.Statements[1] is not a ConstantStatement. got=%T", spec.Statements[1])
+	}
+
+	uncer, ok := con.Value.(*ast.Uncertain)
+	if !ok {
+		t.Fatalf("Constant is not an Uncertain. got=%T", con.Value)
+	}
+
+	if uncer.Distribution != "uniform" {
+		t.Fatalf("Uncertain distribution is not uniform. got=%s", uncer.Distribution)
+	}
+
+	if len(uncer.Params) != 2 || uncer.Params[0] != 0 || uncer.Params[1] != 10 {
+		t.Fatalf("Uncertain params are not 0, 10. got=%v", uncer.Params)
+	}
+
+	if uncer.Mean != 5 {
+		t.Fatalf("Uncertain mean is not 5. got=%f", uncer.Mean)
+	}
+
+	con1, ok := spec.Statements[2].(*ast.ConstantStatement)
+	if !ok {
+		t.Fatalf("spec.Statements[2] is not a ConstantStatement. got=%T", spec.Statements[2])
+	}
+
+	uncer1, ok := con1.Value.(*ast.Uncertain)
+	if !ok {
+		t.Fatalf("Constant is not an Uncertain. got=%T", con1.Value)
+	}
+
+	if uncer1.Distribution != "bernoulli" || uncer1.Mean != 0.2 {
+		t.Fatalf("Uncertain is not bernoulli(0.2). got=%s %f", uncer1.Distribution, uncer1.Mean)
+	}
+
+	if l.Distributions["test1_a"] == nil || l.Distributions["test1_b"] == nil {
+		t.Fatalf("Uncertain distributions not indexed. got=%v", l.Distributions)
+	}
+}
+
 func TestInstanceOrder(t *testing.T) {
 	test := `spec test1;
 
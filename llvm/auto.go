@@ -0,0 +1,143 @@
+package llvm
+
+import (
+	"fault/ast"
+	"fault/util"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Default safety properties, so the standard ways a model
+// can break don't need asserts written by hand: natural
+// stock values never go negative, nothing is divided by
+// zero and every component is in exactly one state.
+
+const ONE_STATE = "one-state" // Operator of a component's assert
+
+func (c *Compiler) trackDivisor(node ast.Node) {
+	e, ok := divisorExpr(node)
+	if !ok { // Constants can't change, nothing to check
+		return
+	}
+
+	name := divisorName(e)
+	if _, ok := c.divisors[name]; !ok {
+		c.divisors[name] = e
+	}
+}
+
+func divisorExpr(node ast.Node) (ast.Expression, bool) {
+	// The divisor with its variables swapped for the
+	// instances they belong to, false when nothing
+	// in it can change between rounds
+	var id []string
+	var token ast.Token
+	switch n := node.(type) {
+	case *ast.ParameterCall:
+		id, token = n.Id(), n.Token
+	case *ast.Identifier:
+		id, token = n.Id(), n.Token
+	case *ast.This:
+		id, token = n.Id(), n.Token
+	case *ast.InfixExpression:
+		l, lok := divisorExpr(n.Left)
+		r, rok := divisorExpr(n.Right)
+		if l == nil || r == nil {
+			return nil, false
+		}
+		return &ast.InfixExpression{Token: n.Token, Left: l, Operator: n.Operator, Right: r}, lok || rok
+	case *ast.IntegerLiteral:
+		return n, false
+	case *ast.FloatLiteral:
+		return n, false
+	default: // Not something an assert can check
+		return nil, false
+	}
+
+	return &ast.AssertVar{Token: token, Instances: []string{strings.Join(id, "_")}}, true
+}
+
+func (c *Compiler) AddAutoProps() {
+	for _, vname := range sortedKeys(c.naturals) {
+		c.compileAssert(autoAssert(&ast.AssertVar{Instances: []string{vname}}, c.naturals[vname], ">=",
+			fmt.Sprintf("%s stays natural", util.SpecName(vname))))
+	}
+
+	var divisors []string
+	for name := range c.divisors {
+		divisors = append(divisors, name)
+	}
+	sort.Strings(divisors)
+	for _, name := range divisors {
+		c.compileAssert(autoAssert(c.divisors[name], c.divisors[name].Position(), "!=",
+			fmt.Sprintf("%s is never a zero divisor", name)))
+	}
+
+	for _, states := range c.states {
+		if len(states) < 3 { // The component and a single state
+			continue
+		}
+
+		// Not an expression the spec could have written,
+		// so it skips compileAssert and goes straight
+		// to the SMT generator
+		c.Asserts = append(c.Asserts, &ast.AssertionStatement{
+			Token: ast.Token{Type: "ASSERT", Literal: "assert"},
+			Constraint: &ast.InvariantClause{
+				Left:     &ast.AssertVar{Instances: states[1:]},
+				Operator: ONE_STATE,
+			},
+			Label: fmt.Sprintf("%s has one active state", util.SpecName(states[0])),
+		})
+	}
+}
+
+func autoAssert(left ast.Expression, pos []int, op string, label string) *ast.AssertionStatement {
+	token := ast.Token{Type: "ASSERT", Literal: "assert"}
+	if len(pos) > 1 {
+		token.Position = pos
+	}
+
+	if v, ok := left.(*ast.AssertVar); ok && v.Token.Type == "" {
+		v.Token = token
+	}
+
+	return &ast.AssertionStatement{
+		Token: token,
+		Constraint: &ast.InvariantClause{
+			Token:    token,
+			Left:     left,
+			Operator: op,
+			Right:    &ast.IntegerLiteral{Token: token, Value: 0},
+		},
+		Label: label,
+	}
+}
+
+func divisorName(e ast.Expression) string {
+	// t.foo.a - t.foo.b, nested operations in brackets
+	switch n := e.(type) {
+	case *ast.AssertVar:
+		return util.SpecName(n.Instances[0])
+	case *ast.InfixExpression:
+		l, r := divisorName(n.Left), divisorName(n.Right)
+		if _, ok := n.Left.(*ast.InfixExpression); ok {
+			l = "(" + l + ")"
+		}
+		if _, ok := n.Right.(*ast.InfixExpression); ok {
+			r = "(" + r + ")"
+		}
+		return fmt.Sprintf("%s %s %s", l, n.Operator, r)
+	}
+	return e.String()
+}
+
+func sortedKeys(m map[string][]int) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -72,7 +72,14 @@ type Compiler struct {
 	Asserts        []*ast.AssertionStatement
 	Assumes        []*ast.AssertionStatement
 	Uncertains     map[string][]float64
+	Distributions  map[string]*ast.Uncertain
 	Unknowns       []string
+	Ranges         map[string][]float64      // bounds on unknowns, min, max and step
+	Positions      map[string][]int          // where each variable is declared in the spec
+	naturals       map[string][]int          // natural stock values and where they're declared
+	divisors       map[string]ast.Expression // what something is divided by, see AddAutoProps
+	states         [][]string                // state variables of each component, in order
+	transitions    []*transition             // advance calls in state functions, see ReachQueries
 	Components     map[string]*StateFunc
 	ComponentOrder []string
 }
@@ -98,15 +105,20 @@ func NewCompiler() *Compiler {
 		specFunctions: make(map[string]value.Value),
 		specGlobals:   make(map[string]*ir.Global),
 		Uncertains:    make(map[string][]float64),
+		Distributions: make(map[string]*ast.Uncertain),
+		Ranges:        make(map[string][]float64),
+		Positions:     make(map[string][]int),
+		naturals:      make(map[string][]int),
+		divisors:      make(map[string]ast.Expression),
 		Components:    make(map[string]*StateFunc),
 	}
 	c.setup()
 	return c
 }
 
-func Execute(tree *ast.Spec, specRec map[string]*preprocess.SpecRecord, uncertains map[string][]float64, unknowns []string, testing bool) *Compiler {
+func Execute(tree *ast.Spec, specRec map[string]*preprocess.SpecRecord, uncertains map[string][]float64, distributions map[string]*ast.Uncertain, unknowns []string, ranges map[string][]float64, testing bool) *Compiler {
 	compiler := NewCompiler()
-	compiler.LoadMeta(specRec, uncertains, unknowns, testing)
+	compiler.LoadMeta(specRec, uncertains, distributions, unknowns, ranges, testing)
 	err := compiler.Compile(tree)
 	if err != nil {
 		panic(err)
@@ -114,10 +126,16 @@ func Execute(tree *ast.Spec, specRec map[string]*preprocess.SpecRecord, uncertai
 	return compiler
 }
 
-func (c *Compiler) LoadMeta(structs map[string]*preprocess.SpecRecord, uncertains map[string][]float64, unknowns []string, test bool) {
+func (c *Compiler) LoadMeta(structs map[string]*preprocess.SpecRecord, uncertains map[string][]float64, distributions map[string]*ast.Uncertain, unknowns []string, ranges map[string][]float64, test bool) {
 	c.specStructs = structs
 	c.Unknowns = unknowns
+	if ranges != nil {
+		c.Ranges = ranges
+	}
 	c.Uncertains = uncertains
+	if distributions != nil {
+		c.Distributions = distributions
+	}
 	c.isTesting = test
 }
 
@@ -297,6 +315,7 @@ func (c *Compiler) compileConstant(node *ast.ConstantStatement) {
 	id := []string{c.currentSpec, node.Name.Value}
 	c.setConst(id, value)
 	c.globalVariable(id, value, node.Position())
+	c.Positions[strings.Join(id, "_")] = node.Position()
 }
 
 func (c *Compiler) setConst(rawid []string, val value.Value) {
@@ -355,6 +374,9 @@ func (c *Compiler) compileValue(node ast.Node) value.Value {
 	case *ast.Natural:
 		return constant.NewFloat(irtypes.Double, float64(v.Value))
 	case *ast.Uncertain: //Set to dummy value for LLVM IR, catch during SMT generation
+		if v.Distribution == "bernoulli" { // A coin flip, left free as a Bool by the SMT generator
+			return constant.NewBool(false)
+		}
 		return constant.NewFloat(irtypes.Double, float64(0.000000000009))
 	case *ast.Unknown:
 		return constant.NewFloat(irtypes.Double, float64(0.000000000009))
@@ -428,6 +450,9 @@ func (c *Compiler) compileComponent(node *ast.ComponentLiteral) {
 		panic(err)
 	}
 
+	states := []string{node.IdString()}
+	defer func() { c.states = append(c.states, states) }()
+
 	for _, k := range node.Order {
 		var pname string
 		p := tree[k]
@@ -444,6 +469,10 @@ func (c *Compiler) compileComponent(node *ast.ComponentLiteral) {
 			//initialize them as false first
 			b := &ast.Boolean{Value: false, ProcessedName: v.ProcessedName}
 			val := c.compileValue(b)
+			states = append(states, v.IdString())
+			if _, ok := c.Positions[v.IdString()]; !ok {
+				c.Positions[v.IdString()] = v.Position()
+			}
 
 			if val != nil {
 				rawid := b.RawId()
@@ -657,6 +686,8 @@ func (c *Compiler) compileFunction(node ast.Node) value.Value {
 
 			c.builtIns[v.Function] = f
 		}
+		c.trackTransition(v)
+
 		var params []value.Value
 		for _, v := range v.Parameters {
 			id := v.(ast.Nameable).IdString()
@@ -789,6 +820,7 @@ func (c *Compiler) compileInfix(node *ast.InfixExpression) value.Value {
 
 		l := c.compileInfixNode(node.Left)
 		r := c.compileInfixNode(node.Right)
+		c.trackDivisor(node.Right)
 
 		div := c.contextBlock.NewFDiv(l, r)
 		return div
@@ -1026,6 +1058,11 @@ func (c *Compiler) compileConditional(n ast.Node) value.Value {
 
 func (c *Compiler) compileAssert(a *ast.AssertionStatement) {
 	var l, r ast.Expression
+	if a.Until != nil {
+		a.Until.Left = c.convertAssertVariables(a.Until.Left)
+		a.Until.Right = c.convertAssertVariables(a.Until.Right)
+	}
+
 	if a.Assume {
 		a.Constraint.Left = c.convertAssertVariables(a.Constraint.Left)
 		a.Constraint.Right = c.convertAssertVariables(a.Constraint.Right)
@@ -1195,8 +1232,8 @@ func (c *Compiler) processStruct(node *ast.StructInstance) map[string]string {
 	var funcs [][]string
 
 	for _, k := range keys {
-		var isUncertain []float64
-		var isUnknown bool
+		var isUncertain *ast.Uncertain
+		var isUnknown *ast.Unknown
 		var id []string
 
 		switch pv := tree[k].Value.(type) {
@@ -1212,13 +1249,16 @@ func (c *Compiler) processStruct(node *ast.StructInstance) map[string]string {
 			funcs = append(funcs, id)
 		default:
 			if n, ok := pv.(*ast.Unknown); ok {
-				isUnknown = true
+				isUnknown = n
 				id = n.Id()
 			} else if uncertain, ok2 := pv.(*ast.Uncertain); ok2 {
-				isUncertain = []float64{uncertain.Mean, uncertain.Sigma}
+				isUncertain = uncertain
 				id = uncertain.Id()
 			} else if n, ok := pv.(*ast.IntegerLiteral); ok {
 				id = n.Id()
+			} else if n, ok := pv.(*ast.Natural); ok {
+				id = n.Id()
+				c.naturals[strings.Join(id, "_")] = n.Position()
 			} else if n, ok := pv.(*ast.FloatLiteral); ok {
 				id = n.Id()
 			} else if n, ok := pv.(*ast.Boolean); ok {
@@ -1247,11 +1287,21 @@ func (c *Compiler) processStruct(node *ast.StructInstance) map[string]string {
 		//Track properties of instances so that we can write
 		// asserts on the struct and honor them for all instances
 		vname := strings.Join(id, "_")
-		if isUnknown {
+		// Where the value was written, the struct
+		// itself if the value doesn't know
+		c.Positions[vname] = tree[k].Token.GetPosition()
+		if v := tree[k].Value; v != nil && v.Position()[0] != 0 {
+			c.Positions[vname] = v.Position()
+		}
+		if isUnknown != nil {
 			c.Unknowns = append(c.Unknowns, vname)
+			if isUnknown.Range != nil {
+				c.Ranges[vname] = isUnknown.Range
+			}
 		}
 		if isUncertain != nil {
-			c.Uncertains[vname] = isUncertain
+			c.Uncertains[vname] = []float64{isUncertain.Mean, isUncertain.Sigma}
+			c.Distributions[vname] = isUncertain
 		}
 		children[vname] = node.Parent[1]
 	}
@@ -1516,6 +1566,16 @@ func negateTemporal(op string, n int) (string, int) {
 	case "nft":
 		op2 = "nmt"
 		n2 = n - 1
+	case "next", "within", "between", "until":
+		// No count to flip, the SMT generator
+		// writes the negation out
+		op2 = "not-" + op
+		n2 = n
+	default:
+		if strings.HasPrefix(op, "not-") {
+			op2 = strings.TrimPrefix(op, "not-")
+			n2 = n
+		}
 	}
 	return op2, n2
 }
@@ -1609,6 +1669,11 @@ func (c *Compiler) GetIR() string {
 	return c.module.String()
 }
 
+func (c *Compiler) States() [][]string {
+	// Each component of a system followed by its states
+	return c.states
+}
+
 func (c *Compiler) setup() {
 	//Initialize Markers
 	c.markers = []*ir.Global{
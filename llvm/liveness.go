@@ -0,0 +1,88 @@
+package llvm
+
+import (
+	"fault/ast"
+	"fault/util"
+	"fmt"
+	"strings"
+)
+
+// Liveness of a system. The reachability package only says
+// whether a state can be reached on paper, this says whether
+// the system keeps moving when it runs. A deadlock is a round
+// where every component is stuck: its state didn't change or
+// it has no active state. A livelock is a cycle, the system
+// comes back to a state it was already in, without visiting
+// any of the progress states along the way.
+
+const (
+	DEADLOCK = "deadlock" // Operator of the deadlock assert
+	LIVELOCK = "livelock" // Operator of the livelock assert
+)
+
+func (c *Compiler) AddLivenessProps(progress []string) error {
+	var visits []string
+	for _, p := range progress {
+		vname, ok := c.findState(p)
+		if !ok {
+			return fmt.Errorf("progress state %s is not a state of any component", p)
+		}
+		visits = append(visits, vname)
+	}
+
+	var components []*ast.InvariantClause
+	var all []string
+	for _, states := range c.states {
+		if len(states) < 2 { // A component without states
+			continue
+		}
+		components = append(components, &ast.InvariantClause{
+			Left:     &ast.AssertVar{Instances: states[1:]},
+			Operator: DEADLOCK,
+		})
+		all = append(all, states[1:]...)
+	}
+
+	if len(components) == 0 {
+		return fmt.Errorf("no component states to check for deadlocks")
+	}
+
+	// Every component has to be stuck at once,
+	// so the components are chained together
+	for i := 0; i < len(components)-1; i++ {
+		components[i].Right = components[i+1]
+	}
+
+	c.Asserts = append(c.Asserts, &ast.AssertionStatement{
+		Token:      ast.Token{Type: "ASSERT", Literal: "assert"},
+		Constraint: components[0],
+		Label:      "no deadlock, some component changes state every round",
+	})
+
+	if len(visits) == 0 {
+		return nil
+	}
+
+	c.Asserts = append(c.Asserts, &ast.AssertionStatement{
+		Token: ast.Token{Type: "ASSERT", Literal: "assert"},
+		Constraint: &ast.InvariantClause{
+			Left:     &ast.AssertVar{Instances: all},
+			Operator: LIVELOCK,
+			Right:    &ast.AssertVar{Instances: visits},
+		},
+		Label: fmt.Sprintf("no livelock, every cycle visits %s", strings.Join(progress, " or ")),
+	})
+	return nil
+}
+
+func (c *Compiler) findState(name string) (string, bool) {
+	// a.foo in the spec is test1_a_foo
+	for _, states := range c.states {
+		for _, s := range states[1:] {
+			if util.SpecName(s) == name {
+				return s, true
+			}
+		}
+	}
+	return "", false
+}
@@ -342,7 +342,7 @@ func TestUnknowns(t *testing.T) {
 func TestParamReset(t *testing.T) {
 	structs := make(map[string]*preprocess.SpecRecord)
 	c := NewCompiler()
-	c.LoadMeta(structs, make(map[string][]float64), []string{}, true)
+	c.LoadMeta(structs, make(map[string][]float64), nil, []string{}, nil, true)
 	s := NewCompiledSpec("test")
 	c.currentSpec = "test"
 	c.specs["test"] = s
@@ -723,6 +723,22 @@ func stripAndEscape(str string) string {
 	return output.String()
 }
 
+func TestPositions(t *testing.T) {
+	c := NewCompiler()
+	c.specs["test"] = NewCompiledSpec("test")
+	test := &ast.StructInstance{Spec: "test", Name: "foo", Parent: []string{"test", "zoo"}, Order: []string{"bar"}, ProcessedName: []string{"test", "foo"}, Properties: map[string]*ast.StructProperty{"bar": {Token: ast.Token{Position: []int{3, 1, 3, 20}}, Spec: "test", Name: "bar", ProcessedName: []string{"test", "foo", "bar"}, Value: &ast.Unknown{Token: ast.Token{Position: []int{4, 6, 4, 15}}, Name: &ast.Identifier{Spec: "test", Value: "bar"}, ProcessedName: []string{"test", "foo", "bar"}}}}}
+	c.processStruct(test)
+
+	pos, ok := c.Positions["test_foo_bar"]
+	if !ok {
+		t.Fatal("position of test_foo_bar not stored")
+	}
+
+	if pos[0] != 4 || pos[1] != 6 {
+		t.Fatalf("position of test_foo_bar is incorrect, got=%v", pos)
+	}
+}
+
 func prepTest(test string, specType bool) (string, error) {
 	flags := make(map[string]bool)
 	flags["specType"] = specType
@@ -733,7 +749,7 @@ func prepTest(test string, specType bool) (string, error) {
 	pre := preprocess.Execute(l)
 	ty := types.Execute(pre.Processed, pre.Specs)
 	compiler := NewCompiler()
-	compiler.LoadMeta(ty.SpecStructs, l.Uncertains, l.Unknowns, true)
+	compiler.LoadMeta(ty.SpecStructs, l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, true)
 	err := compiler.Compile(ty.Checked)
 	if err != nil {
 		return "", err
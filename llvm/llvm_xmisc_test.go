@@ -19,6 +19,16 @@ func TestNegateTemporal(t *testing.T) {
 	if op2 != "nft" || n2 != 3 {
 		t.Fatal("negateTemporal incorrect for nmt")
 	}
+
+	op3, n3 := negateTemporal("within", 2)
+	if op3 != "not-within" || n3 != 2 {
+		t.Fatal("negateTemporal incorrect for within")
+	}
+
+	op4, n4 := negateTemporal("not-between", 2)
+	if op4 != "between" || n4 != 2 {
+		t.Fatal("negateTemporal incorrect for not-between")
+	}
 }
 
 func TestEvalFloat(t *testing.T) {
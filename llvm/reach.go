@@ -0,0 +1,80 @@
+package llvm
+
+import (
+	"fault/ast"
+	"fault/util"
+	"fmt"
+	"strings"
+)
+
+// Which states can a system actually get to? Naming a state
+// in an advance call isn't enough if the guards around the
+// call are never true. Each state and each transition gets
+// a query the solver looks for a model of: a run where the
+// state is entered, or where the transition fires. Queries
+// aren't asserts, they stay out of the model.
+
+const (
+	ENTERED = "entered" // Operator of a state's query
+	FIRES   = "fires"   // Operator of a transition's query
+)
+
+type transition struct {
+	from     string
+	to       string
+	position []int
+}
+
+func (c *Compiler) trackTransition(b *ast.BuiltIn) {
+	if b.Function != "advance" || !strings.HasSuffix(c.contextFuncName, "__state") {
+		return
+	}
+
+	from := strings.TrimSuffix(c.contextFuncName, "__state")
+	for _, p := range b.Parameters {
+		to := p.(ast.Nameable).IdString()
+		if to == from { // Staying put isn't a transition
+			continue
+		}
+		c.transitions = append(c.transitions, &transition{from: from, to: to, position: b.Position()})
+	}
+}
+
+func (c *Compiler) ReachQueries() ([]*ast.AssertionStatement, []*ast.AssertionStatement) {
+	var states []*ast.AssertionStatement
+	for _, component := range c.states {
+		for _, s := range component[1:] {
+			states = append(states, query(c.Positions[s], util.SpecName(s), &ast.InvariantClause{
+				Left:     &ast.AssertVar{Instances: []string{s}},
+				Operator: ENTERED,
+			}))
+		}
+	}
+
+	var transitions []*ast.AssertionStatement
+	seen := make(map[string]bool)
+	for _, t := range c.transitions {
+		label := fmt.Sprintf("%s -> %s", util.SpecName(t.from), util.SpecName(t.to))
+		if seen[label] { // Advanced to from more than one branch
+			continue
+		}
+		seen[label] = true
+
+		transitions = append(transitions, query(t.position, label, &ast.InvariantClause{
+			Left:     &ast.AssertVar{Instances: []string{t.from}},
+			Operator: FIRES,
+			Right:    &ast.AssertVar{Instances: []string{t.to}},
+		}))
+	}
+	return states, transitions
+}
+
+func query(pos []int, label string, constraint *ast.InvariantClause) *ast.AssertionStatement {
+	token := ast.Token{Type: "ASSERT", Literal: "assert", Position: pos}
+	constraint.Token = token
+	return &ast.AssertionStatement{
+		Token:      token,
+		Constraint: constraint,
+		Label:      label,
+	}
+}
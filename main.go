@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fault/ast"
 	"fault/execute"
 	"fault/listener"
@@ -8,6 +9,8 @@ import (
 	"fault/preprocess"
 	"fault/reachability"
 	"fault/smt"
+	"fault/smt/forks"
+	"fault/smt/properties"
 	smtvar "fault/smt/variables"
 	"fault/types"
 	"fault/util"
@@ -17,9 +20,9 @@ import (
 	"log"
 	"os"
 	gopath "path"
+	"strconv"
 	"strings"
-
-	_ "github.com/olekukonko/tablewriter"
+	"time"
 )
 
 func parse(data string, path string, file string, filetype string, reach bool, visu bool) (*ast.Spec, *listener.FaultListener, *types.Checker, string) {
@@ -45,12 +48,34 @@ func parse(data string, path string, file string, filetype string, reach bool, v
 	}
 
 	if reach {
-		r := reachability.NewTracer()
-		r.Scan(ty.Checked)
+		traced(reachability.NewTracer().Scan(ty.Checked), file)
 	}
 	return ty.Checked, lstnr, ty, visual
 }
 
+func traced(r *reachability.Result, file string) {
+	where := func(i *reachability.Issue) string {
+		if i.Line() == 0 {
+			return ""
+		}
+		return fmt.Sprintf(" at %s:%d", gopath.Base(file), i.Line())
+	}
+
+	for _, i := range r.NoTransitions {
+		fmt.Fprintf(os.Stderr, "warning: component %s%s never advances to another state\n", i.Name, where(i))
+	}
+	for _, i := range r.Undefined {
+		fmt.Fprintf(os.Stderr, "error: state %s%s is not defined\n", i.Name, where(i))
+	}
+	for _, i := range r.Unreachable {
+		fmt.Fprintf(os.Stderr, "error: system under specified, state %s%s is unreachable\n", i.Name, where(i))
+	}
+
+	if r.Errors() {
+		os.Exit(1)
+	}
+}
+
 func validate_filetype(data string, filetype string) bool {
 	if filetype == "fspec" && data[0:4] == "spec" {
 		return true
@@ -68,26 +93,178 @@ func smt2(ir string, runs int16, uncertains map[string][]float64, unknowns []str
 	return generator
 }
 
-func probability(smt string, uncertains map[string][]float64, unknowns []string, results map[string][]*smtvar.VarChange) (*execute.ModelChecker, map[string]execute.Scenario) {
+func modelChecker(smt string, uncertains map[string][]float64, distributions map[string]*ast.Uncertain, unknowns []string, ranges map[string][]float64, results map[string][]*smtvar.VarChange, frks []forks.Fork, decisions []*forks.Decision, props []*properties.Property, file string, minProbability float64) *execute.ModelChecker {
 	ex := execute.NewModelChecker()
 	ex.LoadModel(smt, uncertains, unknowns, results)
-	ok, err := ex.Check()
+	ex.LoadMeta(frks, decisions)
+	ex.Distributions = distributions
+	ex.Ranges = ranges
+	ex.Properties = props
+	ex.File = file
+	ex.MinProbability = minProbability
+	return ex
+}
+
+func probability(ctx context.Context, ex *execute.ModelChecker, scenarios int) []map[string]execute.Scenario {
+	found, err := ex.Enumerate(ctx, scenarios)
 	if err != nil {
 		log.Fatalf("model checker has failed: %s", err)
 	}
-	if !ok {
-		fmt.Println("Fault could not find a failure case.")
-		return ex, nil
+
+	var data []map[string]execute.Scenario
+	for _, f := range found {
+		data = append(data, ex.Filter(f))
+	}
+	return ex.Rank(data)
+}
+
+func sanity(ctx context.Context, ex *execute.ModelChecker) {
+	// Look for checks that would pass for the wrong reason
+	_, err := ex.Sanity(ctx)
+	if err != nil {
+		log.Printf("could not check the model for contradictions: %s", err)
+	}
+}
+
+func warnings(ex *execute.ModelChecker) {
+	for _, w := range ex.Warnings {
+		fmt.Println(w)
+	}
+	if len(ex.Warnings) > 0 {
+		fmt.Println()
 	}
-	scenario, err := ex.Solve()
+}
+
+func reachable(ctx context.Context, ex *execute.ModelChecker, states []*properties.Property, transitions []*properties.Property) {
+	// States and transitions no run can get to, given
+	// the guards, mean the system is under specified
+	r, err := ex.Reachability(ctx, states, transitions)
 	if err != nil {
-		log.Fatalf("error found fetching solution from solver: %s", err)
+		log.Fatalf("could not check the system for reachability: %s", err)
+	}
+
+	for _, u := range r.Unknown {
+		fmt.Fprintf(os.Stderr, "warning: could not tell if %s is reachable (%s)\n", u.String(ex.File), u.Reason)
+	}
+
+	if r.Complete() {
+		return
+	}
+
+	for _, u := range r.States {
+		fmt.Fprintf(os.Stderr, "error: state %s is never entered\n", u.String(ex.File))
+	}
+	for _, u := range r.Transitions {
+		fmt.Fprintf(os.Stderr, "error: transition %s never fires\n", u.String(ex.File))
+	}
+	os.Exit(1)
+}
+
+func checkProperties(ctx context.Context, ex *execute.ModelChecker, output string, prove bool) {
+	if len(ex.Properties) == 0 {
+		fmt.Println("Fault found no asserts to check.")
+		return
+	}
+
+	check := ex.CheckProperties
+	if prove {
+		check = ex.Prove
+	}
+
+	results, err := check(ctx)
+	if err != nil {
+		log.Fatalf("model checker has failed: %s", err)
+	}
+
+	if output == "json" {
+		for _, w := range ex.Warnings {
+			log.Println(w)
+		}
+		err := ex.PropertiesJSON(results)
+		if err != nil {
+			log.Fatalf("error formatting results as json: %s", err)
+		}
+		return
+	}
+	warnings(ex)
+	ex.FormatProperties(results)
+}
+
+func incremental(ctx context.Context, ex *execute.ModelChecker, output string) {
+	if len(ex.Properties) == 0 {
+		fmt.Println("Fault found no asserts to check.")
+		return
+	}
+
+	found, err := ex.Incremental(ctx)
+	if err != nil {
+		log.Fatalf("model checker has failed: %s", err)
+	}
+
+	var data []map[string]execute.Scenario
+	for _, f := range found {
+		data = append(data, ex.Filter(f))
+	}
+	display(ctx, ex, data, output)
+}
+
+func display(ctx context.Context, mc *execute.ModelChecker, data []map[string]execute.Scenario, output string) {
+	if len(data) == 0 && mc.Verdict == execute.UNSAT && mc.Unlikely == 0 {
+		// Find out what's keeping the model from failing
+		_, err := mc.Explain(ctx)
+		if err != nil {
+			log.Printf("could not explain why there's no failure: %s", err)
+		}
+	}
+
+	if output == "json" {
+		err := mc.JSON(data...)
+		if err != nil {
+			log.Fatalf("error formatting results as json: %s", err)
+		}
+		return
+	}
+
+	warnings(mc)
+	if mc.Depth > 0 {
+		fmt.Printf("The shortest failure takes %d of %d rounds.\n\n", mc.Depth, mc.Rounds())
+	}
+
+	if mc.Verdict == execute.UNKNOWN {
+		fmt.Printf("Fault could not decide the model, the solver returned unknown (%s).\n", mc.Reason)
+		return
+	}
+
+	if len(data) == 0 && mc.Unlikely > 0 {
+		fmt.Printf("Fault could not find a failure case more likely than %g (%d less likely cases skipped).\n", mc.MinProbability, mc.Unlikely)
+		return
+	}
+
+	if len(data) == 0 {
+		fmt.Println("Fault could not find a failure case.")
+		if len(mc.Core) > 0 {
+			fmt.Println("\nA failure is ruled out by:")
+			for _, c := range mc.Core {
+				fmt.Printf("  %s\n", c.String(mc.File))
+			}
+		}
+		return
+	}
+
+	if len(data) == 1 {
+		fmt.Println("~~~~~~~~~~\n  Fault found the following scenario\n~~~~~~~~~~")
+		mc.Format(data[0])
+		return
+	}
+
+	fmt.Println("~~~~~~~~~~\n  Fault found the following scenarios\n~~~~~~~~~~")
+	for i, d := range data {
+		fmt.Printf("Scenario %d of %d\n\n", i+1, len(data))
+		mc.Format(d)
 	}
-	data := ex.Filter(scenario)
-	return ex, data
 }
 
-func run(filepath string, mode string, input string, reach bool) {
+func run(filepath string, mode string, input string, output string, reach bool, autoProps bool, progress []string, timeout time.Duration, scenarios int, minProbability float64, sanityCheck bool) {
 	filetype := util.DetectMode(filepath)
 	if filetype == "" {
 		log.Fatal("file provided is not a .fspec or .fsystem file")
@@ -95,7 +272,9 @@ func run(filepath string, mode string, input string, reach bool) {
 
 	filepath = util.Filepath(filepath)
 	uncertains := make(map[string][]float64)
+	distributions := make(map[string]*ast.Uncertain)
 	unknowns := []string{}
+	ranges := make(map[string][]float64)
 
 	data, err := os.ReadFile(filepath)
 	if err != nil {
@@ -104,9 +283,19 @@ func run(filepath string, mode string, input string, reach bool) {
 	d := string(data)
 	path := gopath.Dir(filepath)
 
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
 	switch input {
 	case "fspec":
-		tree, lstnr, ty, visual := parse(d, path, filepath, filetype, reach, mode == "visualize")
+		// Systems are checked against the model when there is
+		// one, the syntactic check is for the earlier modes
+		semantic := reach && filetype == "fsystem" && mode != "ast" && mode != "ir" && mode != "smt"
+		tree, lstnr, ty, visual := parse(d, path, filepath, filetype, reach && !semantic, mode == "visualize")
 		if lstnr == nil {
 			log.Fatal("Fault parser returned nil")
 		}
@@ -116,9 +305,28 @@ func run(filepath string, mode string, input string, reach bool) {
 			return
 		}
 
-		compiler := llvm.Execute(tree, ty.SpecStructs, lstnr.Uncertains, lstnr.Unknowns, false)
+		compiler := llvm.Execute(tree, ty.SpecStructs, lstnr.Uncertains, lstnr.Distributions, lstnr.Unknowns, lstnr.Ranges, false)
+		if autoProps {
+			compiler.AddAutoProps()
+		}
+
+		if mode == "deadlock" {
+			if filetype != "fsystem" {
+				log.Fatal("deadlock mode needs a .fsystem file")
+			}
+
+			// Only liveness is checked, the spec's own
+			// asserts are for the other modes
+			compiler.Asserts = nil
+			err := compiler.AddLivenessProps(progress)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
 		uncertains = compiler.Uncertains
+		distributions = compiler.Distributions
 		unknowns = compiler.Unknowns
+		ranges = compiler.Ranges
 
 		if mode == "ir" {
 			fmt.Println(compiler.GetIR())
@@ -137,64 +345,139 @@ func run(filepath string, mode string, input string, reach bool) {
 		}
 
 		generator := smt.Execute(compiler)
+		generator.Optimize = mode == "optimize"
+		generator.PerProperty = mode == "properties" || mode == "incremental" || mode == "prove" || mode == "deadlock"
 		if mode == "smt" {
 			fmt.Println(generator.SMT())
 			return
 		}
 
-		mc, data := probability(generator.SMT(), uncertains, unknowns, generator.Results)
+		mc := modelChecker(generator.SMT(), uncertains, distributions, unknowns, ranges, generator.Results, generator.GetForks(), generator.GetDecisions(), generator.Properties, filepath, minProbability)
+		mc.Positions = compiler.Positions
+		mc.VarRounds = generator.RVarLookup
+		mc.RunRounds = generator.RunRounds
+		mc.Components = compiler.States()
+		defer mc.Close()
+		if semantic {
+			states, transitions := compiler.ReachQueries()
+			reachable(ctx, mc, generator.Queries(states), generator.Queries(transitions))
+		}
+
+		if sanityCheck && mode != "visualize" {
+			sanity(ctx, mc)
+		}
+
+		if mode == "properties" || mode == "prove" || mode == "deadlock" {
+			checkProperties(ctx, mc, output, mode == "prove")
+			return
+		}
+
+		if mode == "incremental" {
+			incremental(ctx, mc, output)
+			return
+		}
+
+		data := probability(ctx, mc, scenarios)
 		if mode == "visualize" {
 			fmt.Println(visual)
 			fmt.Printf("\n\n")
 			mc.Mermaid()
+			if len(data) > 0 {
+				mc.StateChart(data[0])
+			}
 			return
 		}
 
-		if data != nil {
-			mc.LoadMeta(generator.GetForks())
-			fmt.Println("~~~~~~~~~~\n  Fault found the following scenario\n~~~~~~~~~~")
-			mc.Format(data)
-		}
+		display(ctx, mc, data, output)
 	case "ll":
 		generator := smt2(d, 0, uncertains, unknowns, nil, nil)
+		generator.Optimize = mode == "optimize"
 		if mode == "smt" {
 			fmt.Println(generator.SMT())
 			return
 		}
 
-		mc, data := probability(generator.SMT(), uncertains, unknowns, generator.Results)
+		mc := modelChecker(generator.SMT(), uncertains, distributions, unknowns, ranges, generator.Results, generator.GetForks(), generator.GetDecisions(), generator.Properties, filepath, minProbability)
+		defer mc.Close()
+		if sanityCheck && mode != "visualize" {
+			sanity(ctx, mc)
+		}
+
+		if mode == "properties" || mode == "prove" {
+			checkProperties(ctx, mc, output, mode == "prove")
+			return
+		}
+
+		if mode == "incremental" {
+			incremental(ctx, mc, output)
+			return
+		}
+
+		data := probability(ctx, mc, scenarios)
 		if mode == "visualize" {
 			mc.Mermaid()
 			return
 		}
-		if data != nil {
-			mc.LoadMeta(generator.GetForks())
-			fmt.Println("~~~~~~~~~~\n  Fault found the following scenario\n~~~~~~~~~~")
-			mc.Format(data)
-		}
+		display(ctx, mc, data, output)
 	case "smt2":
-		mc, data := probability(d, uncertains, unknowns, make(map[string][]*smtvar.VarChange))
+		mc := modelChecker(d, uncertains, distributions, unknowns, ranges, make(map[string][]*smtvar.VarChange), nil, nil, nil, filepath, minProbability)
+		defer mc.Close()
+		if sanityCheck && mode != "visualize" {
+			sanity(ctx, mc)
+		}
+
+		if mode == "properties" || mode == "prove" {
+			checkProperties(ctx, mc, output, mode == "prove")
+			return
+		}
+
+		if mode == "incremental" {
+			incremental(ctx, mc, output)
+			return
+		}
+
+		data := probability(ctx, mc, scenarios)
 
 		if mode == "visualize" {
 			mc.Mermaid()
 			return
 		}
-		if data != nil {
-			fmt.Println("~~~~~~~~~~\n  Fault found the following scenario\n~~~~~~~~~~")
-			mc.Format(data)
-		}
+		display(ctx, mc, data, output)
+	}
+}
+
+func needsSolver(mode string) bool {
+	// Every mode after smt runs the model checker
+	switch mode {
+	case "ast", "ir", "smt":
+		return false
 	}
+	return true
 }
 
 func main() {
 	var mode string
 	var input string
+	var output string
 	var filepath string
 	var reach bool
-	modeCommand := flag.String("m", "check", "stop compiler at certain milestones: ast, ir, smt, or check")
+	var autoProps bool
+	var progress []string
+	var timeout time.Duration
+	var scenarios int
+	var minProbability float64
+	var sanityCheck bool
+	modeCommand := flag.String("m", "check", "stop compiler at certain milestones: ast, ir, smt, check, optimize (find the failure whose uncertain values are closest to their means, by the sum of |x - mean| / sigma rather than squared distance so z3 stays in linear arithmetic), properties (check each assert on its own), incremental (find the shortest failure), prove (prove asserts for any number of rounds) or deadlock (look for deadlocks and livelocks in a system)")
 	inputCommand := flag.String("i", "fspec", "format of the input file (default: fspec)")
 	fpCommand := flag.String("f", "", "path to file to compile")
-	reachCommand := flag.String("c", "false", "make sure the transitions to all defined states are specified in the model")
+	outputCommand := flag.String("o", "text", "format of the results: text or json")
+	reachCommand := flag.String("c", "false", "make sure every state of a system can be entered and every transition can fire, given the guards in the model")
+	autoPropsCommand := flag.String("auto-props", "false", "add asserts that natural values never go negative, divisors are never zero and every component has one active state")
+	progressCommand := flag.String("progress", "", "states a system must keep visiting in deadlock mode, a cycle that misses all of them is a livelock (ie a.done,b.sent)")
+	scenariosCommand := flag.String("scenarios", "1", "number of distinct failure scenarios to look for")
+	minProbCommand := flag.String("min-probability", "", "skip scenarios whose uncertain values are this unlikely (ie 0.01), for continuous distributions the chance of a value at least as far out")
+	timeoutCommand := flag.String("timeout", "", "stop the solver after this long (ie 30s, 5m) and report unknown")
+	sanityCommand := flag.String("sanity", "true", "before checking, warn about rules and assumes that contradict each other and asserts that can't be violated")
 
 	flag.Parse()
 
@@ -214,6 +497,11 @@ func main() {
 		case "ir":
 		case "smt":
 		case "check":
+		case "optimize":
+		case "properties":
+		case "incremental":
+		case "prove":
+		case "deadlock":
 		case "visualize":
 		default:
 			fmt.Printf("%s is not a valid mode\n", mode)
@@ -221,13 +509,29 @@ func main() {
 		}
 	}
 
+	if *progressCommand != "" {
+		if mode != "deadlock" {
+			fmt.Println("-progress only applies to -m deadlock")
+			os.Exit(1)
+		}
+		for _, p := range strings.Split(*progressCommand, ",") {
+			progress = append(progress, strings.TrimSpace(p))
+		}
+	}
+
 	//Check if solver is set
-	if (mode == "check" || mode == "visualize") &&
-		os.Getenv("SOLVERCMD") == "" || os.Getenv("SOLVERARG") == "" {
-		fmt.Printf("\n no solver configured, defaulting to SMT output without model checking. Please set SOLVERCMD and SOLVERARG variables.\n\n")
+	if needsSolver(mode) && os.Getenv("SOLVERCMD") == "" {
+		fmt.Printf("\n no solver configured, defaulting to SMT output without model checking. Please set the SOLVERCMD variable.\n\n")
 		mode = "smt"
 	}
 
+	if mode == "optimize" {
+		if s := execute.GenerateSolver(); !s.Optimizes() {
+			fmt.Printf("%s does not support optimization, please use z3 or -m check\n", s.Name())
+			os.Exit(1)
+		}
+	}
+
 	if *inputCommand == "" {
 		input = "fspec"
 	} else {
@@ -242,6 +546,24 @@ func main() {
 		}
 	}
 
+	if mode == "deadlock" && input != "fspec" {
+		fmt.Println("deadlock mode needs a system spec, not compiled input")
+		os.Exit(1)
+	}
+
+	if *outputCommand == "" {
+		output = "text"
+	} else {
+		output = strings.ToLower(*outputCommand)
+		switch output {
+		case "text":
+		case "json":
+		default:
+			fmt.Printf("%s is not a valid output format\n", output)
+			os.Exit(1)
+		}
+	}
+
 	if *reachCommand == "" {
 		reach = false
 	} else {
@@ -261,5 +583,54 @@ func main() {
 		}
 	}
 
-	run(filepath, mode, input, reach)
+	switch strings.ToLower(*autoPropsCommand) {
+	case "true", "t":
+		autoProps = true
+	case "false", "f", "":
+		autoProps = false
+	default:
+		fmt.Printf("%s is not a valid option for auto-props please use true or false\n", *autoPropsCommand)
+		os.Exit(1)
+	}
+
+	switch strings.ToLower(*sanityCommand) {
+	case "true", "t", "":
+		sanityCheck = true
+	case "false", "f":
+		sanityCheck = false
+	default:
+		fmt.Printf("%s is not a valid option for sanity please use true or false\n", *sanityCommand)
+		os.Exit(1)
+	}
+
+	if *timeoutCommand != "" {
+		t, err := time.ParseDuration(*timeoutCommand)
+		if err != nil || t < 0 {
+			fmt.Printf("%s is not a valid timeout, please use a duration like 30s or 5m\n", *timeoutCommand)
+			os.Exit(1)
+		}
+		timeout = t
+	}
+
+	if *scenariosCommand == "" {
+		scenarios = 1
+	} else {
+		n, err := strconv.Atoi(*scenariosCommand)
+		if err != nil || n < 1 {
+			fmt.Printf("%s is not a valid number of scenarios, please use a whole number greater than zero\n", *scenariosCommand)
+			os.Exit(1)
+		}
+		scenarios = n
+	}
+
+	if *minProbCommand != "" {
+		p, err := strconv.ParseFloat(*minProbCommand, 64)
+		if err != nil || p < 0 {
+			fmt.Printf("%s is not a valid minimum probability, please use a positive number like 0.01\n", *minProbCommand)
+			os.Exit(1)
+		}
+		minProbability = p
+	}
+
+	run(filepath, mode, input, output, reach, autoProps, progress, timeout, scenarios, minProbability, sanityCheck)
 }
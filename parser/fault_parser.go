@@ -33,32 +33,32 @@ var faultparserParserStaticData struct {
 func faultparserParserInit() {
 	staticData := &faultparserParserStaticData
 	staticData.literalNames = []string{
-		"", "'all'", "'assert'", "'assume'", "'clock'", "'const'", "'def'",
-		"'else'", "'flow'", "'for'", "'func'", "'if'", "'import'", "'init'",
-		"'new'", "'return'", "'run'", "'spec'", "'stock'", "'then'", "'when'",
-		"'this'", "'eventually'", "'eventually-always'", "'always'", "'nmt'",
-		"'nft'", "'nil'", "'true'", "'false'", "'advance'", "'component'", "'global'",
-		"'system'", "'start'", "'states'", "'stay'", "'string'", "'bool'", "'int'",
-		"'float'", "'natural'", "'uncertain'", "'unknown'", "", "'='", "'->'",
-		"'<-'", "':'", "','", "'.'", "'('", "')'", "'{'", "'}'", "'['", "']'",
-		"';'", "'++'", "'--'", "'&'", "'&&'", "'!'", "'=='", "'!='", "'<'",
-		"'<='", "'>'", "'>='", "'||'", "'|'", "'+'", "'-'", "'^'", "'**'", "'*'",
-		"'/'", "'%'", "'<<'", "'>>'", "'&^'",
+		"", "'all'", "'any'", "'assert'", "'assume'", "'clock'", "'const'",
+		"'def'", "'else'", "'flow'", "'for'", "'func'", "'if'", "'import'",
+		"'init'", "'new'", "'return'", "'run'", "'spec'", "'stock'", "'then'",
+		"'when'", "'this'", "'eventually'", "'eventually-always'", "'always'",
+		"'nmt'", "'nft'", "'nil'", "'true'", "'false'", "'advance'", "'component'",
+		"'global'", "'system'", "'start'", "'states'", "'stay'", "'string'",
+		"'bool'", "'int'", "'float'", "'natural'", "'uncertain'", "'unknown'",
+		"", "'='", "'->'", "'<-'", "':'", "','", "'.'", "'('", "')'", "'{'",
+		"'}'", "'['", "']'", "';'", "'++'", "'--'", "'&'", "'&&'", "'!'", "'=='",
+		"'!='", "'<'", "'<='", "'>'", "'>='", "'||'", "'|'", "'+'", "'-'", "'^'",
+		"'**'", "'*'", "'/'", "'%'", "'<<'", "'>>'", "'&^'",
 	}
 	staticData.symbolicNames = []string{
-		"", "ALL", "ASSERT", "ASSUME", "CLOCK", "CONST", "DEF", "ELSE", "FLOW",
-		"FOR", "FUNC", "IF", "IMPORT", "INIT", "NEW", "RETURN", "RUN", "SPEC",
-		"STOCK", "THEN", "WHEN", "THIS", "EVENTUALLY", "EVENTUALLYALWAYS", "ALWAYS",
-		"NMT", "NFT", "NIL", "TRUE", "FALSE", "ADVANCE", "COMPONENT", "GLOBAL",
-		"SYSTEM", "START", "STATE", "STAY", "TY_STRING", "TY_BOOL", "TY_INT",
-		"TY_FLOAT", "TY_NATURAL", "TY_UNCERTAIN", "TY_UNKNOWN", "IDENT", "ASSIGN",
-		"ASSIGN_FLOW1", "ASSIGN_FLOW2", "COLON", "COMMA", "DOT", "LPAREN", "RPAREN",
-		"LCURLY", "RCURLY", "LBRACE", "RBRACE", "SEMI", "PLUS_PLUS", "MINUS_MINUS",
-		"AMPERSAND", "AND", "BANG", "EQUALS", "NOT_EQUALS", "LESS", "LESS_OR_EQUALS",
-		"GREATER", "GREATER_OR_EQUALS", "OR", "PIPE", "PLUS", "MINUS", "CARET",
-		"EXPO", "MULTI", "DIV", "MOD", "LSHIFT", "RSHIFT", "BIT_CLEAR", "DECIMAL_LIT",
-		"OCTAL_LIT", "HEX_LIT", "FLOAT_LIT", "RAW_STRING_LIT", "INTERPRETED_STRING_LIT",
-		"WS", "COMMENT", "TERMINATOR", "LINE_COMMENT",
+		"", "ALL", "ANY", "ASSERT", "ASSUME", "CLOCK", "CONST", "DEF", "ELSE",
+		"FLOW", "FOR", "FUNC", "IF", "IMPORT", "INIT", "NEW", "RETURN", "RUN",
+		"SPEC", "STOCK", "THEN", "WHEN", "THIS", "EVENTUALLY", "EVENTUALLYALWAYS",
+		"ALWAYS", "NMT", "NFT", "NIL", "TRUE", "FALSE", "ADVANCE", "COMPONENT",
+		"GLOBAL", "SYSTEM", "START", "STATE", "STAY", "TY_STRING", "TY_BOOL",
+		"TY_INT", "TY_FLOAT", "TY_NATURAL", "TY_UNCERTAIN", "TY_UNKNOWN", "IDENT",
+		"ASSIGN", "ASSIGN_FLOW1", "ASSIGN_FLOW2", "COLON", "COMMA", "DOT", "LPAREN",
+		"RPAREN", "LCURLY", "RCURLY", "LBRACE", "RBRACE", "SEMI", "PLUS_PLUS",
+		"MINUS_MINUS", "AMPERSAND", "AND", "BANG", "EQUALS", "NOT_EQUALS", "LESS",
+		"LESS_OR_EQUALS", "GREATER", "GREATER_OR_EQUALS", "OR", "PIPE", "PLUS",
+		"MINUS", "CARET", "EXPO", "MULTI", "DIV", "MOD", "LSHIFT", "RSHIFT",
+		"BIT_CLEAR", "DECIMAL_LIT", "OCTAL_LIT", "HEX_LIT", "FLOAT_LIT", "RAW_STRING_LIT",
+		"INTERPRETED_STRING_LIT", "WS", "COMMENT", "TERMINATOR", "LINE_COMMENT",
 	}
 	staticData.ruleNames = []string{
 		"sysSpec", "sysClause", "globalDecl", "componentDecl", "startBlock",
@@ -67,16 +67,16 @@ func faultparserParserInit() {
 		"constants", "nil", "expressionList", "structDecl", "structType", "sfProperties",
 		"comProperties", "structProperties", "initDecl", "block", "statementList",
 		"statement", "simpleStmt", "incDecStmt", "stateChange", "accessHistory",
-		"assertion", "assumption", "temporal", "invariant", "assignment", "emptyStmt",
-		"ifStmt", "ifStmtRun", "ifStmtState", "forStmt", "rounds", "paramCall",
-		"stateBlock", "stateStep", "runBlock", "runStep", "faultType", "solvable",
-		"expression", "operand", "operandName", "prefix", "numeric", "integer",
-		"negative", "float_", "string_", "bool_", "functionLit", "stateLit",
-		"eos",
+		"assertion", "assumption", "quantifier", "temporal", "invariant", "assignment",
+		"emptyStmt", "ifStmt", "ifStmtRun", "ifStmtState", "forStmt", "rounds",
+		"paramCall", "stateBlock", "stateStep", "runBlock", "runStep", "faultType",
+		"solvable", "expression", "operand", "operandName", "prefix", "numeric",
+		"integer", "negative", "float_", "string_", "bool_", "functionLit",
+		"stateLit", "eos",
 	}
 	staticData.predictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 90, 681, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 1, 91, 701, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
 		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
 		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
 		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
@@ -87,304 +87,314 @@ func faultparserParserInit() {
 		42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2, 47,
 		7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52, 7,
 		52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57,
-		2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 1,
-		0, 1, 0, 5, 0, 129, 8, 0, 10, 0, 12, 0, 132, 9, 0, 1, 0, 5, 0, 135, 8,
-		0, 10, 0, 12, 0, 138, 9, 0, 1, 0, 5, 0, 141, 8, 0, 10, 0, 12, 0, 144, 9,
-		0, 1, 0, 1, 0, 3, 0, 148, 8, 0, 1, 0, 3, 0, 151, 8, 0, 1, 0, 3, 0, 154,
-		8, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3,
-		1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 5, 3, 174, 8, 3, 10, 3, 12, 3,
-		177, 9, 3, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 5, 4, 187, 8,
-		4, 10, 4, 12, 4, 190, 9, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1,
-		6, 1, 6, 5, 6, 201, 8, 6, 10, 6, 12, 6, 204, 9, 6, 1, 6, 3, 6, 207, 8,
-		6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 5, 8, 217, 8, 8, 10,
-		8, 12, 8, 220, 9, 8, 1, 8, 3, 8, 223, 8, 8, 1, 8, 1, 8, 1, 9, 3, 9, 228,
-		8, 9, 1, 9, 1, 9, 3, 9, 232, 8, 9, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1,
-		11, 3, 11, 240, 8, 11, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13,
-		1, 13, 5, 13, 250, 8, 13, 10, 13, 12, 13, 253, 9, 13, 1, 13, 1, 13, 3,
-		13, 257, 8, 13, 1, 14, 1, 14, 1, 14, 3, 14, 262, 8, 14, 1, 15, 1, 15, 1,
-		15, 5, 15, 267, 8, 15, 10, 15, 12, 15, 270, 9, 15, 1, 16, 1, 16, 1, 16,
-		1, 16, 1, 16, 3, 16, 277, 8, 16, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 5,
-		18, 284, 8, 18, 10, 18, 12, 18, 287, 9, 18, 1, 19, 1, 19, 1, 19, 1, 19,
-		1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 5, 20, 300, 8, 20, 10,
-		20, 12, 20, 303, 9, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 5, 20,
-		311, 8, 20, 10, 20, 12, 20, 314, 9, 20, 1, 20, 3, 20, 317, 8, 20, 1, 21,
-		1, 21, 1, 21, 1, 21, 3, 21, 323, 8, 21, 1, 22, 1, 22, 1, 22, 1, 22, 3,
-		22, 329, 8, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23,
+		2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 2,
+		63, 7, 63, 1, 0, 1, 0, 5, 0, 131, 8, 0, 10, 0, 12, 0, 134, 9, 0, 1, 0,
+		5, 0, 137, 8, 0, 10, 0, 12, 0, 140, 9, 0, 1, 0, 5, 0, 143, 8, 0, 10, 0,
+		12, 0, 146, 9, 0, 1, 0, 1, 0, 3, 0, 150, 8, 0, 1, 0, 3, 0, 153, 8, 0, 1,
+		0, 3, 0, 156, 8, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1,
+		2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 5, 3, 176, 8,
+		3, 10, 3, 12, 3, 179, 9, 3, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1,
+		4, 5, 4, 189, 8, 4, 10, 4, 12, 4, 192, 9, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1,
+		5, 1, 5, 1, 5, 1, 6, 1, 6, 5, 6, 203, 8, 6, 10, 6, 12, 6, 206, 9, 6, 1,
+		6, 3, 6, 209, 8, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 5,
+		8, 219, 8, 8, 10, 8, 12, 8, 222, 9, 8, 1, 8, 3, 8, 225, 8, 8, 1, 8, 1,
+		8, 1, 9, 3, 9, 230, 8, 9, 1, 9, 1, 9, 3, 9, 234, 8, 9, 1, 10, 1, 10, 1,
+		11, 1, 11, 1, 11, 1, 11, 3, 11, 242, 8, 11, 1, 12, 1, 12, 1, 13, 1, 13,
+		1, 13, 1, 13, 1, 13, 1, 13, 5, 13, 252, 8, 13, 10, 13, 12, 13, 255, 9,
+		13, 1, 13, 1, 13, 3, 13, 259, 8, 13, 1, 14, 1, 14, 1, 14, 3, 14, 264, 8,
+		14, 1, 15, 1, 15, 1, 15, 5, 15, 269, 8, 15, 10, 15, 12, 15, 272, 9, 15,
+		1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 279, 8, 16, 1, 17, 1, 17, 1,
+		18, 1, 18, 1, 18, 5, 18, 286, 8, 18, 10, 18, 12, 18, 289, 9, 18, 1, 19,
+		1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 5,
+		20, 302, 8, 20, 10, 20, 12, 20, 305, 9, 20, 1, 20, 1, 20, 1, 20, 1, 20,
+		1, 20, 1, 20, 5, 20, 313, 8, 20, 10, 20, 12, 20, 316, 9, 20, 1, 20, 3,
+		20, 319, 8, 20, 1, 21, 1, 21, 1, 21, 1, 21, 3, 21, 325, 8, 21, 1, 22, 1,
+		22, 1, 22, 1, 22, 3, 22, 331, 8, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23,
 		1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1,
-		23, 3, 23, 350, 8, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 3, 25,
-		358, 8, 25, 1, 25, 1, 25, 1, 26, 4, 26, 363, 8, 26, 11, 26, 12, 26, 364,
-		1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 374, 8, 27, 1,
-		28, 1, 28, 1, 28, 1, 28, 3, 28, 380, 8, 28, 1, 29, 1, 29, 1, 29, 1, 30,
-		1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 3, 30, 394, 8,
-		30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 5, 30, 402, 8, 30, 10, 30,
-		12, 30, 405, 9, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 4, 31, 412, 8, 31,
-		11, 31, 12, 31, 413, 1, 32, 1, 32, 1, 32, 3, 32, 419, 8, 32, 1, 32, 1,
-		32, 1, 33, 1, 33, 1, 33, 3, 33, 426, 8, 33, 1, 33, 1, 33, 1, 34, 1, 34,
-		1, 34, 3, 34, 433, 8, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 3,
-		35, 441, 8, 35, 1, 36, 1, 36, 3, 36, 445, 8, 36, 1, 36, 1, 36, 1, 36, 1,
-		36, 1, 36, 1, 36, 1, 36, 3, 36, 454, 8, 36, 1, 37, 1, 37, 1, 38, 1, 38,
-		1, 38, 1, 38, 3, 38, 462, 8, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 3,
-		38, 469, 8, 38, 3, 38, 471, 8, 38, 1, 39, 1, 39, 1, 39, 1, 39, 3, 39, 477,
-		8, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 3, 39, 484, 8, 39, 3, 39, 486,
-		8, 39, 1, 40, 1, 40, 1, 40, 1, 40, 3, 40, 492, 8, 40, 1, 40, 1, 40, 1,
-		40, 1, 40, 1, 40, 3, 40, 499, 8, 40, 3, 40, 501, 8, 40, 1, 41, 1, 41, 1,
-		41, 1, 41, 1, 41, 3, 41, 508, 8, 41, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43,
-		1, 43, 1, 43, 5, 43, 517, 8, 43, 10, 43, 12, 43, 520, 9, 43, 1, 44, 1,
-		44, 5, 44, 524, 8, 44, 10, 44, 12, 44, 527, 9, 44, 1, 44, 1, 44, 1, 45,
-		1, 45, 1, 45, 5, 45, 534, 8, 45, 10, 45, 12, 45, 537, 9, 45, 1, 45, 1,
-		45, 1, 45, 1, 45, 1, 45, 1, 45, 3, 45, 545, 8, 45, 1, 46, 1, 46, 5, 46,
-		549, 8, 46, 10, 46, 12, 46, 552, 9, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1,
-		47, 5, 47, 559, 8, 47, 10, 47, 12, 47, 562, 9, 47, 1, 47, 1, 47, 1, 47,
-		1, 47, 1, 47, 1, 47, 1, 47, 3, 47, 571, 8, 47, 1, 47, 1, 47, 1, 47, 1,
-		47, 1, 47, 3, 47, 578, 8, 47, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 3, 49,
-		585, 8, 49, 1, 49, 1, 49, 5, 49, 589, 8, 49, 10, 49, 12, 49, 592, 9, 49,
-		1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50, 3, 50, 600, 8, 50, 1, 50, 1,
-		50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50,
-		1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 5, 50, 620, 8, 50, 10, 50, 12,
-		50, 623, 9, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51,
-		1, 51, 1, 51, 3, 51, 635, 8, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1,
-		52, 1, 52, 1, 52, 3, 52, 645, 8, 52, 3, 52, 647, 8, 52, 1, 53, 1, 53, 1,
-		53, 3, 53, 652, 8, 53, 1, 54, 1, 54, 1, 54, 3, 54, 657, 8, 54, 1, 55, 1,
-		55, 1, 56, 1, 56, 1, 56, 1, 56, 3, 56, 665, 8, 56, 1, 57, 1, 57, 1, 58,
-		1, 58, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 62, 1,
-		62, 1, 62, 0, 2, 60, 100, 63, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22,
-		24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58,
-		60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94,
-		96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124,
-		0, 15, 2, 0, 44, 44, 50, 50, 1, 0, 63, 68, 1, 0, 58, 59, 1, 0, 22, 24,
-		1, 0, 25, 26, 3, 0, 60, 60, 71, 73, 75, 80, 1, 0, 46, 47, 2, 0, 21, 21,
-		44, 44, 1, 0, 37, 43, 2, 0, 60, 60, 75, 80, 1, 0, 71, 73, 4, 0, 60, 60,
-		62, 62, 71, 73, 75, 75, 1, 0, 81, 83, 1, 0, 85, 86, 1, 0, 28, 29, 720,
-		0, 126, 1, 0, 0, 0, 2, 155, 1, 0, 0, 0, 4, 159, 1, 0, 0, 0, 6, 165, 1,
-		0, 0, 0, 8, 181, 1, 0, 0, 0, 10, 194, 1, 0, 0, 0, 12, 198, 1, 0, 0, 0,
-		14, 208, 1, 0, 0, 0, 16, 212, 1, 0, 0, 0, 18, 227, 1, 0, 0, 0, 20, 233,
-		1, 0, 0, 0, 22, 239, 1, 0, 0, 0, 24, 241, 1, 0, 0, 0, 26, 243, 1, 0, 0,
-		0, 28, 258, 1, 0, 0, 0, 30, 263, 1, 0, 0, 0, 32, 276, 1, 0, 0, 0, 34, 278,
-		1, 0, 0, 0, 36, 280, 1, 0, 0, 0, 38, 288, 1, 0, 0, 0, 40, 316, 1, 0, 0,
-		0, 42, 322, 1, 0, 0, 0, 44, 328, 1, 0, 0, 0, 46, 349, 1, 0, 0, 0, 48, 351,
-		1, 0, 0, 0, 50, 355, 1, 0, 0, 0, 52, 362, 1, 0, 0, 0, 54, 373, 1, 0, 0,
-		0, 56, 379, 1, 0, 0, 0, 58, 381, 1, 0, 0, 0, 60, 393, 1, 0, 0, 0, 62, 406,
-		1, 0, 0, 0, 64, 415, 1, 0, 0, 0, 66, 422, 1, 0, 0, 0, 68, 432, 1, 0, 0,
-		0, 70, 440, 1, 0, 0, 0, 72, 453, 1, 0, 0, 0, 74, 455, 1, 0, 0, 0, 76, 457,
-		1, 0, 0, 0, 78, 472, 1, 0, 0, 0, 80, 487, 1, 0, 0, 0, 82, 502, 1, 0, 0,
-		0, 84, 509, 1, 0, 0, 0, 86, 511, 1, 0, 0, 0, 88, 521, 1, 0, 0, 0, 90, 544,
-		1, 0, 0, 0, 92, 546, 1, 0, 0, 0, 94, 577, 1, 0, 0, 0, 96, 579, 1, 0, 0,
-		0, 98, 581, 1, 0, 0, 0, 100, 599, 1, 0, 0, 0, 102, 634, 1, 0, 0, 0, 104,
-		646, 1, 0, 0, 0, 106, 651, 1, 0, 0, 0, 108, 656, 1, 0, 0, 0, 110, 658,
-		1, 0, 0, 0, 112, 664, 1, 0, 0, 0, 114, 666, 1, 0, 0, 0, 116, 668, 1, 0,
-		0, 0, 118, 670, 1, 0, 0, 0, 120, 672, 1, 0, 0, 0, 122, 675, 1, 0, 0, 0,
-		124, 678, 1, 0, 0, 0, 126, 130, 3, 2, 1, 0, 127, 129, 3, 16, 8, 0, 128,
-		127, 1, 0, 0, 0, 129, 132, 1, 0, 0, 0, 130, 128, 1, 0, 0, 0, 130, 131,
-		1, 0, 0, 0, 131, 136, 1, 0, 0, 0, 132, 130, 1, 0, 0, 0, 133, 135, 3, 4,
-		2, 0, 134, 133, 1, 0, 0, 0, 135, 138, 1, 0, 0, 0, 136, 134, 1, 0, 0, 0,
-		136, 137, 1, 0, 0, 0, 137, 142, 1, 0, 0, 0, 138, 136, 1, 0, 0, 0, 139,
-		141, 3, 6, 3, 0, 140, 139, 1, 0, 0, 0, 141, 144, 1, 0, 0, 0, 142, 140,
-		1, 0, 0, 0, 142, 143, 1, 0, 0, 0, 143, 147, 1, 0, 0, 0, 144, 142, 1, 0,
-		0, 0, 145, 148, 3, 64, 32, 0, 146, 148, 3, 66, 33, 0, 147, 145, 1, 0, 0,
-		0, 147, 146, 1, 0, 0, 0, 147, 148, 1, 0, 0, 0, 148, 150, 1, 0, 0, 0, 149,
-		151, 3, 8, 4, 0, 150, 149, 1, 0, 0, 0, 150, 151, 1, 0, 0, 0, 151, 153,
-		1, 0, 0, 0, 152, 154, 3, 82, 41, 0, 153, 152, 1, 0, 0, 0, 153, 154, 1,
-		0, 0, 0, 154, 1, 1, 0, 0, 0, 155, 156, 5, 33, 0, 0, 156, 157, 5, 44, 0,
-		0, 157, 158, 3, 124, 62, 0, 158, 3, 1, 0, 0, 0, 159, 160, 5, 32, 0, 0,
-		160, 161, 5, 44, 0, 0, 161, 162, 5, 45, 0, 0, 162, 163, 3, 102, 51, 0,
-		163, 164, 3, 124, 62, 0, 164, 5, 1, 0, 0, 0, 165, 166, 5, 31, 0, 0, 166,
-		167, 5, 44, 0, 0, 167, 168, 5, 45, 0, 0, 168, 169, 5, 35, 0, 0, 169, 175,
-		5, 53, 0, 0, 170, 171, 3, 44, 22, 0, 171, 172, 5, 49, 0, 0, 172, 174, 1,
-		0, 0, 0, 173, 170, 1, 0, 0, 0, 174, 177, 1, 0, 0, 0, 175, 173, 1, 0, 0,
-		0, 175, 176, 1, 0, 0, 0, 176, 178, 1, 0, 0, 0, 177, 175, 1, 0, 0, 0, 178,
-		179, 5, 54, 0, 0, 179, 180, 3, 124, 62, 0, 180, 7, 1, 0, 0, 0, 181, 182,
-		5, 34, 0, 0, 182, 188, 5, 53, 0, 0, 183, 184, 3, 10, 5, 0, 184, 185, 5,
-		49, 0, 0, 185, 187, 1, 0, 0, 0, 186, 183, 1, 0, 0, 0, 187, 190, 1, 0, 0,
-		0, 188, 186, 1, 0, 0, 0, 188, 189, 1, 0, 0, 0, 189, 191, 1, 0, 0, 0, 190,
-		188, 1, 0, 0, 0, 191, 192, 5, 54, 0, 0, 192, 193, 3, 124, 62, 0, 193, 9,
-		1, 0, 0, 0, 194, 195, 5, 44, 0, 0, 195, 196, 5, 48, 0, 0, 196, 197, 5,
-		44, 0, 0, 197, 11, 1, 0, 0, 0, 198, 202, 3, 14, 7, 0, 199, 201, 3, 22,
-		11, 0, 200, 199, 1, 0, 0, 0, 201, 204, 1, 0, 0, 0, 202, 200, 1, 0, 0, 0,
-		202, 203, 1, 0, 0, 0, 203, 206, 1, 0, 0, 0, 204, 202, 1, 0, 0, 0, 205,
-		207, 3, 82, 41, 0, 206, 205, 1, 0, 0, 0, 206, 207, 1, 0, 0, 0, 207, 13,
-		1, 0, 0, 0, 208, 209, 5, 17, 0, 0, 209, 210, 5, 44, 0, 0, 210, 211, 3,
-		124, 62, 0, 211, 15, 1, 0, 0, 0, 212, 222, 5, 12, 0, 0, 213, 223, 3, 18,
-		9, 0, 214, 218, 5, 51, 0, 0, 215, 217, 3, 18, 9, 0, 216, 215, 1, 0, 0,
-		0, 217, 220, 1, 0, 0, 0, 218, 216, 1, 0, 0, 0, 218, 219, 1, 0, 0, 0, 219,
-		221, 1, 0, 0, 0, 220, 218, 1, 0, 0, 0, 221, 223, 5, 52, 0, 0, 222, 213,
-		1, 0, 0, 0, 222, 214, 1, 0, 0, 0, 223, 224, 1, 0, 0, 0, 224, 225, 3, 124,
-		62, 0, 225, 17, 1, 0, 0, 0, 226, 228, 7, 0, 0, 0, 227, 226, 1, 0, 0, 0,
-		227, 228, 1, 0, 0, 0, 228, 229, 1, 0, 0, 0, 229, 231, 3, 20, 10, 0, 230,
-		232, 5, 49, 0, 0, 231, 230, 1, 0, 0, 0, 231, 232, 1, 0, 0, 0, 232, 19,
-		1, 0, 0, 0, 233, 234, 3, 116, 58, 0, 234, 21, 1, 0, 0, 0, 235, 240, 3,
-		26, 13, 0, 236, 240, 3, 38, 19, 0, 237, 240, 3, 64, 32, 0, 238, 240, 3,
-		66, 33, 0, 239, 235, 1, 0, 0, 0, 239, 236, 1, 0, 0, 0, 239, 237, 1, 0,
-		0, 0, 239, 238, 1, 0, 0, 0, 240, 23, 1, 0, 0, 0, 241, 242, 7, 1, 0, 0,
-		242, 25, 1, 0, 0, 0, 243, 256, 5, 5, 0, 0, 244, 245, 3, 28, 14, 0, 245,
-		246, 3, 124, 62, 0, 246, 257, 1, 0, 0, 0, 247, 251, 5, 51, 0, 0, 248, 250,
-		3, 28, 14, 0, 249, 248, 1, 0, 0, 0, 250, 253, 1, 0, 0, 0, 251, 249, 1,
-		0, 0, 0, 251, 252, 1, 0, 0, 0, 252, 254, 1, 0, 0, 0, 253, 251, 1, 0, 0,
-		0, 254, 255, 5, 52, 0, 0, 255, 257, 3, 124, 62, 0, 256, 244, 1, 0, 0, 0,
-		256, 247, 1, 0, 0, 0, 257, 27, 1, 0, 0, 0, 258, 261, 3, 30, 15, 0, 259,
-		260, 5, 45, 0, 0, 260, 262, 3, 32, 16, 0, 261, 259, 1, 0, 0, 0, 261, 262,
-		1, 0, 0, 0, 262, 29, 1, 0, 0, 0, 263, 268, 3, 104, 52, 0, 264, 265, 5,
-		49, 0, 0, 265, 267, 3, 104, 52, 0, 266, 264, 1, 0, 0, 0, 267, 270, 1, 0,
-		0, 0, 268, 266, 1, 0, 0, 0, 268, 269, 1, 0, 0, 0, 269, 31, 1, 0, 0, 0,
-		270, 268, 1, 0, 0, 0, 271, 277, 3, 108, 54, 0, 272, 277, 3, 116, 58, 0,
-		273, 277, 3, 118, 59, 0, 274, 277, 3, 98, 49, 0, 275, 277, 3, 34, 17, 0,
-		276, 271, 1, 0, 0, 0, 276, 272, 1, 0, 0, 0, 276, 273, 1, 0, 0, 0, 276,
-		274, 1, 0, 0, 0, 276, 275, 1, 0, 0, 0, 277, 33, 1, 0, 0, 0, 278, 279, 5,
-		27, 0, 0, 279, 35, 1, 0, 0, 0, 280, 285, 3, 100, 50, 0, 281, 282, 5, 49,
-		0, 0, 282, 284, 3, 100, 50, 0, 283, 281, 1, 0, 0, 0, 284, 287, 1, 0, 0,
-		0, 285, 283, 1, 0, 0, 0, 285, 286, 1, 0, 0, 0, 286, 37, 1, 0, 0, 0, 287,
-		285, 1, 0, 0, 0, 288, 289, 5, 6, 0, 0, 289, 290, 5, 44, 0, 0, 290, 291,
-		5, 45, 0, 0, 291, 292, 3, 40, 20, 0, 292, 293, 3, 124, 62, 0, 293, 39,
-		1, 0, 0, 0, 294, 295, 5, 8, 0, 0, 295, 301, 5, 53, 0, 0, 296, 297, 3, 42,
-		21, 0, 297, 298, 5, 49, 0, 0, 298, 300, 1, 0, 0, 0, 299, 296, 1, 0, 0,
-		0, 300, 303, 1, 0, 0, 0, 301, 299, 1, 0, 0, 0, 301, 302, 1, 0, 0, 0, 302,
-		304, 1, 0, 0, 0, 303, 301, 1, 0, 0, 0, 304, 317, 5, 54, 0, 0, 305, 306,
-		5, 18, 0, 0, 306, 312, 5, 53, 0, 0, 307, 308, 3, 42, 21, 0, 308, 309, 5,
-		49, 0, 0, 309, 311, 1, 0, 0, 0, 310, 307, 1, 0, 0, 0, 311, 314, 1, 0, 0,
-		0, 312, 310, 1, 0, 0, 0, 312, 313, 1, 0, 0, 0, 313, 315, 1, 0, 0, 0, 314,
-		312, 1, 0, 0, 0, 315, 317, 5, 54, 0, 0, 316, 294, 1, 0, 0, 0, 316, 305,
-		1, 0, 0, 0, 317, 41, 1, 0, 0, 0, 318, 319, 5, 44, 0, 0, 319, 320, 5, 48,
-		0, 0, 320, 323, 3, 120, 60, 0, 321, 323, 3, 46, 23, 0, 322, 318, 1, 0,
-		0, 0, 322, 321, 1, 0, 0, 0, 323, 43, 1, 0, 0, 0, 324, 325, 5, 44, 0, 0,
-		325, 326, 5, 48, 0, 0, 326, 329, 3, 122, 61, 0, 327, 329, 3, 46, 23, 0,
-		328, 324, 1, 0, 0, 0, 328, 327, 1, 0, 0, 0, 329, 45, 1, 0, 0, 0, 330, 331,
-		5, 44, 0, 0, 331, 332, 5, 48, 0, 0, 332, 350, 3, 108, 54, 0, 333, 334,
-		5, 44, 0, 0, 334, 335, 5, 48, 0, 0, 335, 350, 3, 116, 58, 0, 336, 337,
-		5, 44, 0, 0, 337, 338, 5, 48, 0, 0, 338, 350, 3, 118, 59, 0, 339, 340,
-		5, 44, 0, 0, 340, 341, 5, 48, 0, 0, 341, 350, 3, 104, 52, 0, 342, 343,
-		5, 44, 0, 0, 343, 344, 5, 48, 0, 0, 344, 350, 3, 106, 53, 0, 345, 346,
-		5, 44, 0, 0, 346, 347, 5, 48, 0, 0, 347, 350, 3, 98, 49, 0, 348, 350, 5,
-		44, 0, 0, 349, 330, 1, 0, 0, 0, 349, 333, 1, 0, 0, 0, 349, 336, 1, 0, 0,
-		0, 349, 339, 1, 0, 0, 0, 349, 342, 1, 0, 0, 0, 349, 345, 1, 0, 0, 0, 349,
-		348, 1, 0, 0, 0, 350, 47, 1, 0, 0, 0, 351, 352, 5, 13, 0, 0, 352, 353,
-		3, 102, 51, 0, 353, 354, 3, 124, 62, 0, 354, 49, 1, 0, 0, 0, 355, 357,
-		5, 53, 0, 0, 356, 358, 3, 52, 26, 0, 357, 356, 1, 0, 0, 0, 357, 358, 1,
-		0, 0, 0, 358, 359, 1, 0, 0, 0, 359, 360, 5, 54, 0, 0, 360, 51, 1, 0, 0,
-		0, 361, 363, 3, 54, 27, 0, 362, 361, 1, 0, 0, 0, 363, 364, 1, 0, 0, 0,
-		364, 362, 1, 0, 0, 0, 364, 365, 1, 0, 0, 0, 365, 53, 1, 0, 0, 0, 366, 374,
-		3, 26, 13, 0, 367, 374, 3, 48, 24, 0, 368, 369, 3, 56, 28, 0, 369, 370,
-		3, 124, 62, 0, 370, 374, 1, 0, 0, 0, 371, 374, 3, 50, 25, 0, 372, 374,
-		3, 76, 38, 0, 373, 366, 1, 0, 0, 0, 373, 367, 1, 0, 0, 0, 373, 368, 1,
-		0, 0, 0, 373, 371, 1, 0, 0, 0, 373, 372, 1, 0, 0, 0, 374, 55, 1, 0, 0,
-		0, 375, 380, 3, 100, 50, 0, 376, 380, 3, 58, 29, 0, 377, 380, 3, 72, 36,
-		0, 378, 380, 3, 74, 37, 0, 379, 375, 1, 0, 0, 0, 379, 376, 1, 0, 0, 0,
-		379, 377, 1, 0, 0, 0, 379, 378, 1, 0, 0, 0, 380, 57, 1, 0, 0, 0, 381, 382,
-		3, 100, 50, 0, 382, 383, 7, 2, 0, 0, 383, 59, 1, 0, 0, 0, 384, 385, 6,
-		30, -1, 0, 385, 386, 5, 30, 0, 0, 386, 387, 5, 51, 0, 0, 387, 388, 3, 86,
-		43, 0, 388, 389, 5, 52, 0, 0, 389, 394, 1, 0, 0, 0, 390, 391, 5, 36, 0,
-		0, 391, 392, 5, 51, 0, 0, 392, 394, 5, 52, 0, 0, 393, 384, 1, 0, 0, 0,
-		393, 390, 1, 0, 0, 0, 394, 403, 1, 0, 0, 0, 395, 396, 10, 2, 0, 0, 396,
-		397, 5, 61, 0, 0, 397, 402, 3, 60, 30, 3, 398, 399, 10, 1, 0, 0, 399, 400,
-		5, 69, 0, 0, 400, 402, 3, 60, 30, 2, 401, 395, 1, 0, 0, 0, 401, 398, 1,
-		0, 0, 0, 402, 405, 1, 0, 0, 0, 403, 401, 1, 0, 0, 0, 403, 404, 1, 0, 0,
-		0, 404, 61, 1, 0, 0, 0, 405, 403, 1, 0, 0, 0, 406, 411, 3, 104, 52, 0,
-		407, 408, 5, 55, 0, 0, 408, 409, 3, 100, 50, 0, 409, 410, 5, 56, 0, 0,
-		410, 412, 1, 0, 0, 0, 411, 407, 1, 0, 0, 0, 412, 413, 1, 0, 0, 0, 413,
-		411, 1, 0, 0, 0, 413, 414, 1, 0, 0, 0, 414, 63, 1, 0, 0, 0, 415, 416, 5,
-		2, 0, 0, 416, 418, 3, 70, 35, 0, 417, 419, 3, 68, 34, 0, 418, 417, 1, 0,
-		0, 0, 418, 419, 1, 0, 0, 0, 419, 420, 1, 0, 0, 0, 420, 421, 3, 124, 62,
-		0, 421, 65, 1, 0, 0, 0, 422, 423, 5, 3, 0, 0, 423, 425, 3, 70, 35, 0, 424,
-		426, 3, 68, 34, 0, 425, 424, 1, 0, 0, 0, 425, 426, 1, 0, 0, 0, 426, 427,
-		1, 0, 0, 0, 427, 428, 3, 124, 62, 0, 428, 67, 1, 0, 0, 0, 429, 433, 7,
-		3, 0, 0, 430, 431, 7, 4, 0, 0, 431, 433, 3, 110, 55, 0, 432, 429, 1, 0,
-		0, 0, 432, 430, 1, 0, 0, 0, 433, 69, 1, 0, 0, 0, 434, 441, 3, 100, 50,
-		0, 435, 436, 5, 20, 0, 0, 436, 437, 3, 100, 50, 0, 437, 438, 5, 19, 0,
-		0, 438, 439, 3, 100, 50, 0, 439, 441, 1, 0, 0, 0, 440, 434, 1, 0, 0, 0,
-		440, 435, 1, 0, 0, 0, 441, 71, 1, 0, 0, 0, 442, 444, 3, 36, 18, 0, 443,
-		445, 7, 5, 0, 0, 444, 443, 1, 0, 0, 0, 444, 445, 1, 0, 0, 0, 445, 446,
-		1, 0, 0, 0, 446, 447, 5, 45, 0, 0, 447, 448, 3, 36, 18, 0, 448, 454, 1,
-		0, 0, 0, 449, 450, 3, 36, 18, 0, 450, 451, 7, 6, 0, 0, 451, 452, 3, 36,
-		18, 0, 452, 454, 1, 0, 0, 0, 453, 442, 1, 0, 0, 0, 453, 449, 1, 0, 0, 0,
-		454, 73, 1, 0, 0, 0, 455, 456, 5, 57, 0, 0, 456, 75, 1, 0, 0, 0, 457, 461,
-		5, 11, 0, 0, 458, 459, 3, 56, 28, 0, 459, 460, 5, 57, 0, 0, 460, 462, 1,
-		0, 0, 0, 461, 458, 1, 0, 0, 0, 461, 462, 1, 0, 0, 0, 462, 463, 1, 0, 0,
-		0, 463, 464, 3, 100, 50, 0, 464, 470, 3, 50, 25, 0, 465, 468, 5, 7, 0,
-		0, 466, 469, 3, 76, 38, 0, 467, 469, 3, 50, 25, 0, 468, 466, 1, 0, 0, 0,
-		468, 467, 1, 0, 0, 0, 469, 471, 1, 0, 0, 0, 470, 465, 1, 0, 0, 0, 470,
-		471, 1, 0, 0, 0, 471, 77, 1, 0, 0, 0, 472, 476, 5, 11, 0, 0, 473, 474,
-		3, 56, 28, 0, 474, 475, 5, 57, 0, 0, 475, 477, 1, 0, 0, 0, 476, 473, 1,
-		0, 0, 0, 476, 477, 1, 0, 0, 0, 477, 478, 1, 0, 0, 0, 478, 479, 3, 100,
-		50, 0, 479, 485, 3, 92, 46, 0, 480, 483, 5, 7, 0, 0, 481, 484, 3, 78, 39,
-		0, 482, 484, 3, 92, 46, 0, 483, 481, 1, 0, 0, 0, 483, 482, 1, 0, 0, 0,
-		484, 486, 1, 0, 0, 0, 485, 480, 1, 0, 0, 0, 485, 486, 1, 0, 0, 0, 486,
-		79, 1, 0, 0, 0, 487, 491, 5, 11, 0, 0, 488, 489, 3, 56, 28, 0, 489, 490,
-		5, 57, 0, 0, 490, 492, 1, 0, 0, 0, 491, 488, 1, 0, 0, 0, 491, 492, 1, 0,
-		0, 0, 492, 493, 1, 0, 0, 0, 493, 494, 3, 100, 50, 0, 494, 500, 3, 88, 44,
-		0, 495, 498, 5, 7, 0, 0, 496, 499, 3, 80, 40, 0, 497, 499, 3, 88, 44, 0,
-		498, 496, 1, 0, 0, 0, 498, 497, 1, 0, 0, 0, 499, 501, 1, 0, 0, 0, 500,
-		495, 1, 0, 0, 0, 500, 501, 1, 0, 0, 0, 501, 81, 1, 0, 0, 0, 502, 503, 5,
-		9, 0, 0, 503, 504, 3, 84, 42, 0, 504, 505, 5, 16, 0, 0, 505, 507, 3, 92,
-		46, 0, 506, 508, 3, 124, 62, 0, 507, 506, 1, 0, 0, 0, 507, 508, 1, 0, 0,
-		0, 508, 83, 1, 0, 0, 0, 509, 510, 3, 110, 55, 0, 510, 85, 1, 0, 0, 0, 511,
-		512, 7, 7, 0, 0, 512, 513, 5, 50, 0, 0, 513, 518, 5, 44, 0, 0, 514, 515,
-		5, 50, 0, 0, 515, 517, 5, 44, 0, 0, 516, 514, 1, 0, 0, 0, 517, 520, 1,
-		0, 0, 0, 518, 516, 1, 0, 0, 0, 518, 519, 1, 0, 0, 0, 519, 87, 1, 0, 0,
-		0, 520, 518, 1, 0, 0, 0, 521, 525, 5, 53, 0, 0, 522, 524, 3, 90, 45, 0,
-		523, 522, 1, 0, 0, 0, 524, 527, 1, 0, 0, 0, 525, 523, 1, 0, 0, 0, 525,
-		526, 1, 0, 0, 0, 526, 528, 1, 0, 0, 0, 527, 525, 1, 0, 0, 0, 528, 529,
-		5, 54, 0, 0, 529, 89, 1, 0, 0, 0, 530, 535, 3, 86, 43, 0, 531, 532, 5,
-		70, 0, 0, 532, 534, 3, 86, 43, 0, 533, 531, 1, 0, 0, 0, 534, 537, 1, 0,
-		0, 0, 535, 533, 1, 0, 0, 0, 535, 536, 1, 0, 0, 0, 536, 538, 1, 0, 0, 0,
-		537, 535, 1, 0, 0, 0, 538, 539, 3, 124, 62, 0, 539, 545, 1, 0, 0, 0, 540,
-		541, 3, 60, 30, 0, 541, 542, 3, 124, 62, 0, 542, 545, 1, 0, 0, 0, 543,
-		545, 3, 80, 40, 0, 544, 530, 1, 0, 0, 0, 544, 540, 1, 0, 0, 0, 544, 543,
-		1, 0, 0, 0, 545, 91, 1, 0, 0, 0, 546, 550, 5, 53, 0, 0, 547, 549, 3, 94,
-		47, 0, 548, 547, 1, 0, 0, 0, 549, 552, 1, 0, 0, 0, 550, 548, 1, 0, 0, 0,
-		550, 551, 1, 0, 0, 0, 551, 553, 1, 0, 0, 0, 552, 550, 1, 0, 0, 0, 553,
-		554, 5, 54, 0, 0, 554, 93, 1, 0, 0, 0, 555, 560, 3, 86, 43, 0, 556, 557,
-		5, 70, 0, 0, 557, 559, 3, 86, 43, 0, 558, 556, 1, 0, 0, 0, 559, 562, 1,
-		0, 0, 0, 560, 558, 1, 0, 0, 0, 560, 561, 1, 0, 0, 0, 561, 563, 1, 0, 0,
-		0, 562, 560, 1, 0, 0, 0, 563, 564, 3, 124, 62, 0, 564, 578, 1, 0, 0, 0,
-		565, 566, 5, 44, 0, 0, 566, 567, 5, 45, 0, 0, 567, 570, 5, 14, 0, 0, 568,
-		571, 3, 86, 43, 0, 569, 571, 5, 44, 0, 0, 570, 568, 1, 0, 0, 0, 570, 569,
-		1, 0, 0, 0, 571, 572, 1, 0, 0, 0, 572, 578, 3, 124, 62, 0, 573, 574, 3,
-		56, 28, 0, 574, 575, 3, 124, 62, 0, 575, 578, 1, 0, 0, 0, 576, 578, 3,
-		78, 39, 0, 577, 555, 1, 0, 0, 0, 577, 565, 1, 0, 0, 0, 577, 573, 1, 0,
-		0, 0, 577, 576, 1, 0, 0, 0, 578, 95, 1, 0, 0, 0, 579, 580, 7, 8, 0, 0,
-		580, 97, 1, 0, 0, 0, 581, 582, 3, 96, 48, 0, 582, 584, 5, 51, 0, 0, 583,
-		585, 3, 102, 51, 0, 584, 583, 1, 0, 0, 0, 584, 585, 1, 0, 0, 0, 585, 590,
-		1, 0, 0, 0, 586, 587, 5, 49, 0, 0, 587, 589, 3, 102, 51, 0, 588, 586, 1,
-		0, 0, 0, 589, 592, 1, 0, 0, 0, 590, 588, 1, 0, 0, 0, 590, 591, 1, 0, 0,
-		0, 591, 593, 1, 0, 0, 0, 592, 590, 1, 0, 0, 0, 593, 594, 5, 52, 0, 0, 594,
-		99, 1, 0, 0, 0, 595, 596, 6, 50, -1, 0, 596, 600, 3, 102, 51, 0, 597, 600,
-		3, 98, 49, 0, 598, 600, 3, 106, 53, 0, 599, 595, 1, 0, 0, 0, 599, 597,
-		1, 0, 0, 0, 599, 598, 1, 0, 0, 0, 600, 621, 1, 0, 0, 0, 601, 602, 10, 6,
-		0, 0, 602, 603, 5, 74, 0, 0, 603, 620, 3, 100, 50, 7, 604, 605, 10, 5,
-		0, 0, 605, 606, 7, 9, 0, 0, 606, 620, 3, 100, 50, 6, 607, 608, 10, 4, 0,
-		0, 608, 609, 7, 10, 0, 0, 609, 620, 3, 100, 50, 5, 610, 611, 10, 3, 0,
-		0, 611, 612, 7, 1, 0, 0, 612, 620, 3, 100, 50, 4, 613, 614, 10, 2, 0, 0,
-		614, 615, 5, 61, 0, 0, 615, 620, 3, 100, 50, 3, 616, 617, 10, 1, 0, 0,
-		617, 618, 5, 69, 0, 0, 618, 620, 3, 100, 50, 2, 619, 601, 1, 0, 0, 0, 619,
-		604, 1, 0, 0, 0, 619, 607, 1, 0, 0, 0, 619, 610, 1, 0, 0, 0, 619, 613,
-		1, 0, 0, 0, 619, 616, 1, 0, 0, 0, 620, 623, 1, 0, 0, 0, 621, 619, 1, 0,
-		0, 0, 621, 622, 1, 0, 0, 0, 622, 101, 1, 0, 0, 0, 623, 621, 1, 0, 0, 0,
-		624, 635, 3, 34, 17, 0, 625, 635, 3, 108, 54, 0, 626, 635, 3, 116, 58,
-		0, 627, 635, 3, 118, 59, 0, 628, 635, 3, 104, 52, 0, 629, 635, 3, 62, 31,
-		0, 630, 631, 5, 51, 0, 0, 631, 632, 3, 100, 50, 0, 632, 633, 5, 52, 0,
-		0, 633, 635, 1, 0, 0, 0, 634, 624, 1, 0, 0, 0, 634, 625, 1, 0, 0, 0, 634,
-		626, 1, 0, 0, 0, 634, 627, 1, 0, 0, 0, 634, 628, 1, 0, 0, 0, 634, 629,
-		1, 0, 0, 0, 634, 630, 1, 0, 0, 0, 635, 103, 1, 0, 0, 0, 636, 647, 5, 44,
-		0, 0, 637, 647, 3, 86, 43, 0, 638, 647, 5, 21, 0, 0, 639, 647, 5, 4, 0,
-		0, 640, 641, 5, 14, 0, 0, 641, 644, 5, 44, 0, 0, 642, 643, 5, 50, 0, 0,
-		643, 645, 5, 44, 0, 0, 644, 642, 1, 0, 0, 0, 644, 645, 1, 0, 0, 0, 645,
-		647, 1, 0, 0, 0, 646, 636, 1, 0, 0, 0, 646, 637, 1, 0, 0, 0, 646, 638,
-		1, 0, 0, 0, 646, 639, 1, 0, 0, 0, 646, 640, 1, 0, 0, 0, 647, 105, 1, 0,
-		0, 0, 648, 652, 1, 0, 0, 0, 649, 650, 7, 11, 0, 0, 650, 652, 3, 100, 50,
-		0, 651, 648, 1, 0, 0, 0, 651, 649, 1, 0, 0, 0, 652, 107, 1, 0, 0, 0, 653,
-		657, 3, 110, 55, 0, 654, 657, 3, 112, 56, 0, 655, 657, 3, 114, 57, 0, 656,
-		653, 1, 0, 0, 0, 656, 654, 1, 0, 0, 0, 656, 655, 1, 0, 0, 0, 657, 109,
-		1, 0, 0, 0, 658, 659, 7, 12, 0, 0, 659, 111, 1, 0, 0, 0, 660, 661, 5, 72,
-		0, 0, 661, 665, 3, 110, 55, 0, 662, 663, 5, 72, 0, 0, 663, 665, 3, 114,
-		57, 0, 664, 660, 1, 0, 0, 0, 664, 662, 1, 0, 0, 0, 665, 113, 1, 0, 0, 0,
-		666, 667, 5, 84, 0, 0, 667, 115, 1, 0, 0, 0, 668, 669, 7, 13, 0, 0, 669,
-		117, 1, 0, 0, 0, 670, 671, 7, 14, 0, 0, 671, 119, 1, 0, 0, 0, 672, 673,
-		5, 10, 0, 0, 673, 674, 3, 50, 25, 0, 674, 121, 1, 0, 0, 0, 675, 676, 5,
-		10, 0, 0, 676, 677, 3, 88, 44, 0, 677, 123, 1, 0, 0, 0, 678, 679, 5, 57,
-		0, 0, 679, 125, 1, 0, 0, 0, 70, 130, 136, 142, 147, 150, 153, 175, 188,
-		202, 206, 218, 222, 227, 231, 239, 251, 256, 261, 268, 276, 285, 301, 312,
-		316, 322, 328, 349, 357, 364, 373, 379, 393, 401, 403, 413, 418, 425, 432,
-		440, 444, 453, 461, 468, 470, 476, 483, 485, 491, 498, 500, 507, 518, 525,
-		535, 544, 550, 560, 570, 577, 584, 590, 599, 619, 621, 634, 644, 646, 651,
-		656, 664,
+		23, 1, 23, 1, 23, 1, 23, 3, 23, 352, 8, 23, 1, 24, 1, 24, 1, 24, 1, 24,
+		1, 25, 1, 25, 3, 25, 360, 8, 25, 1, 25, 1, 25, 1, 26, 4, 26, 365, 8, 26,
+		11, 26, 12, 26, 366, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 3,
+		27, 376, 8, 27, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28, 382, 8, 28, 1, 29, 1,
+		29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30,
+		3, 30, 396, 8, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 5, 30, 404,
+		8, 30, 10, 30, 12, 30, 407, 9, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 4,
+		31, 414, 8, 31, 11, 31, 12, 31, 415, 1, 32, 1, 32, 3, 32, 420, 8, 32, 1,
+		32, 1, 32, 3, 32, 424, 8, 32, 1, 32, 1, 32, 1, 33, 1, 33, 3, 33, 430, 8,
+		33, 1, 33, 1, 33, 3, 33, 434, 8, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 35,
+		1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 3, 35, 447, 8, 35, 3, 35, 449,
+		8, 35, 1, 35, 1, 35, 3, 35, 453, 8, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1,
+		36, 1, 36, 3, 36, 461, 8, 36, 1, 37, 1, 37, 3, 37, 465, 8, 37, 1, 37, 1,
+		37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 3, 37, 474, 8, 37, 1, 38, 1, 38,
+		1, 39, 1, 39, 1, 39, 1, 39, 3, 39, 482, 8, 39, 1, 39, 1, 39, 1, 39, 1,
+		39, 1, 39, 3, 39, 489, 8, 39, 3, 39, 491, 8, 39, 1, 40, 1, 40, 1, 40, 1,
+		40, 3, 40, 497, 8, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 3, 40, 504, 8,
+		40, 3, 40, 506, 8, 40, 1, 41, 1, 41, 1, 41, 1, 41, 3, 41, 512, 8, 41, 1,
+		41, 1, 41, 1, 41, 1, 41, 1, 41, 3, 41, 519, 8, 41, 3, 41, 521, 8, 41, 1,
+		42, 1, 42, 1, 42, 1, 42, 1, 42, 3, 42, 528, 8, 42, 1, 43, 1, 43, 1, 44,
+		1, 44, 1, 44, 1, 44, 1, 44, 5, 44, 537, 8, 44, 10, 44, 12, 44, 540, 9,
+		44, 1, 45, 1, 45, 5, 45, 544, 8, 45, 10, 45, 12, 45, 547, 9, 45, 1, 45,
+		1, 45, 1, 46, 1, 46, 1, 46, 5, 46, 554, 8, 46, 10, 46, 12, 46, 557, 9,
+		46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 3, 46, 565, 8, 46, 1, 47,
+		1, 47, 5, 47, 569, 8, 47, 10, 47, 12, 47, 572, 9, 47, 1, 47, 1, 47, 1,
+		48, 1, 48, 1, 48, 5, 48, 579, 8, 48, 10, 48, 12, 48, 582, 9, 48, 1, 48,
+		1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 3, 48, 591, 8, 48, 1, 48, 1,
+		48, 1, 48, 1, 48, 1, 48, 3, 48, 598, 8, 48, 1, 49, 1, 49, 1, 50, 1, 50,
+		1, 50, 3, 50, 605, 8, 50, 1, 50, 1, 50, 5, 50, 609, 8, 50, 10, 50, 12,
+		50, 612, 9, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 51, 3, 51, 620, 8,
+		51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51,
+		1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 5, 51, 640, 8,
+		51, 10, 51, 12, 51, 643, 9, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52,
+		1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 655, 8, 52, 1, 53, 1, 53, 1, 53, 1,
+		53, 1, 53, 1, 53, 1, 53, 1, 53, 3, 53, 665, 8, 53, 3, 53, 667, 8, 53, 1,
+		54, 1, 54, 1, 54, 3, 54, 672, 8, 54, 1, 55, 1, 55, 1, 55, 3, 55, 677, 8,
+		55, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 685, 8, 57, 1, 58,
+		1, 58, 1, 59, 1, 59, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1,
+		62, 1, 63, 1, 63, 1, 63, 0, 2, 60, 102, 64, 0, 2, 4, 6, 8, 10, 12, 14,
+		16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50,
+		52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86,
+		88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118,
+		120, 122, 124, 126, 0, 16, 2, 0, 45, 45, 51, 51, 1, 0, 64, 69, 1, 0, 59,
+		60, 1, 0, 1, 2, 1, 0, 23, 25, 1, 0, 26, 27, 3, 0, 61, 61, 72, 74, 76, 81,
+		1, 0, 47, 48, 2, 0, 22, 22, 45, 45, 1, 0, 38, 44, 2, 0, 61, 61, 76, 81,
+		1, 0, 72, 74, 4, 0, 61, 61, 63, 63, 72, 74, 76, 76, 1, 0, 82, 84, 1, 0,
+		86, 87, 1, 0, 29, 30, 745, 0, 128, 1, 0, 0, 0, 2, 157, 1, 0, 0, 0, 4, 161,
+		1, 0, 0, 0, 6, 167, 1, 0, 0, 0, 8, 183, 1, 0, 0, 0, 10, 196, 1, 0, 0, 0,
+		12, 200, 1, 0, 0, 0, 14, 210, 1, 0, 0, 0, 16, 214, 1, 0, 0, 0, 18, 229,
+		1, 0, 0, 0, 20, 235, 1, 0, 0, 0, 22, 241, 1, 0, 0, 0, 24, 243, 1, 0, 0,
+		0, 26, 245, 1, 0, 0, 0, 28, 260, 1, 0, 0, 0, 30, 265, 1, 0, 0, 0, 32, 278,
+		1, 0, 0, 0, 34, 280, 1, 0, 0, 0, 36, 282, 1, 0, 0, 0, 38, 290, 1, 0, 0,
+		0, 40, 318, 1, 0, 0, 0, 42, 324, 1, 0, 0, 0, 44, 330, 1, 0, 0, 0, 46, 351,
+		1, 0, 0, 0, 48, 353, 1, 0, 0, 0, 50, 357, 1, 0, 0, 0, 52, 364, 1, 0, 0,
+		0, 54, 375, 1, 0, 0, 0, 56, 381, 1, 0, 0, 0, 58, 383, 1, 0, 0, 0, 60, 395,
+		1, 0, 0, 0, 62, 408, 1, 0, 0, 0, 64, 417, 1, 0, 0, 0, 66, 427, 1, 0, 0,
+		0, 68, 437, 1, 0, 0, 0, 70, 452, 1, 0, 0, 0, 72, 460, 1, 0, 0, 0, 74, 473,
+		1, 0, 0, 0, 76, 475, 1, 0, 0, 0, 78, 477, 1, 0, 0, 0, 80, 492, 1, 0, 0,
+		0, 82, 507, 1, 0, 0, 0, 84, 522, 1, 0, 0, 0, 86, 529, 1, 0, 0, 0, 88, 531,
+		1, 0, 0, 0, 90, 541, 1, 0, 0, 0, 92, 564, 1, 0, 0, 0, 94, 566, 1, 0, 0,
+		0, 96, 597, 1, 0, 0, 0, 98, 599, 1, 0, 0, 0, 100, 601, 1, 0, 0, 0, 102,
+		619, 1, 0, 0, 0, 104, 654, 1, 0, 0, 0, 106, 666, 1, 0, 0, 0, 108, 671,
+		1, 0, 0, 0, 110, 676, 1, 0, 0, 0, 112, 678, 1, 0, 0, 0, 114, 684, 1, 0,
+		0, 0, 116, 686, 1, 0, 0, 0, 118, 688, 1, 0, 0, 0, 120, 690, 1, 0, 0, 0,
+		122, 692, 1, 0, 0, 0, 124, 695, 1, 0, 0, 0, 126, 698, 1, 0, 0, 0, 128,
+		132, 3, 2, 1, 0, 129, 131, 3, 16, 8, 0, 130, 129, 1, 0, 0, 0, 131, 134,
+		1, 0, 0, 0, 132, 130, 1, 0, 0, 0, 132, 133, 1, 0, 0, 0, 133, 138, 1, 0,
+		0, 0, 134, 132, 1, 0, 0, 0, 135, 137, 3, 4, 2, 0, 136, 135, 1, 0, 0, 0,
+		137, 140, 1, 0, 0, 0, 138, 136, 1, 0, 0, 0, 138, 139, 1, 0, 0, 0, 139,
+		144, 1, 0, 0, 0, 140, 138, 1, 0, 0, 0, 141, 143, 3, 6, 3, 0, 142, 141,
+		1, 0, 0, 0, 143, 146, 1, 0, 0, 0, 144, 142, 1, 0, 0, 0, 144, 145, 1, 0,
+		0, 0, 145, 149, 1, 0, 0, 0, 146, 144, 1, 0, 0, 0, 147, 150, 3, 64, 32,
+		0, 148, 150, 3, 66, 33, 0, 149, 147, 1, 0, 0, 0, 149, 148, 1, 0, 0, 0,
+		149, 150, 1, 0, 0, 0, 150, 152, 1, 0, 0, 0, 151, 153, 3, 8, 4, 0, 152,
+		151, 1, 0, 0, 0, 152, 153, 1, 0, 0, 0, 153, 155, 1, 0, 0, 0, 154, 156,
+		3, 84, 42, 0, 155, 154, 1, 0, 0, 0, 155, 156, 1, 0, 0, 0, 156, 1, 1, 0,
+		0, 0, 157, 158, 5, 34, 0, 0, 158, 159, 5, 45, 0, 0, 159, 160, 3, 126, 63,
+		0, 160, 3, 1, 0, 0, 0, 161, 162, 5, 33, 0, 0, 162, 163, 5, 45, 0, 0, 163,
+		164, 5, 46, 0, 0, 164, 165, 3, 104, 52, 0, 165, 166, 3, 126, 63, 0, 166,
+		5, 1, 0, 0, 0, 167, 168, 5, 32, 0, 0, 168, 169, 5, 45, 0, 0, 169, 170,
+		5, 46, 0, 0, 170, 171, 5, 36, 0, 0, 171, 177, 5, 54, 0, 0, 172, 173, 3,
+		44, 22, 0, 173, 174, 5, 50, 0, 0, 174, 176, 1, 0, 0, 0, 175, 172, 1, 0,
+		0, 0, 176, 179, 1, 0, 0, 0, 177, 175, 1, 0, 0, 0, 177, 178, 1, 0, 0, 0,
+		178, 180, 1, 0, 0, 0, 179, 177, 1, 0, 0, 0, 180, 181, 5, 55, 0, 0, 181,
+		182, 3, 126, 63, 0, 182, 7, 1, 0, 0, 0, 183, 184, 5, 35, 0, 0, 184, 190,
+		5, 54, 0, 0, 185, 186, 3, 10, 5, 0, 186, 187, 5, 50, 0, 0, 187, 189, 1,
+		0, 0, 0, 188, 185, 1, 0, 0, 0, 189, 192, 1, 0, 0, 0, 190, 188, 1, 0, 0,
+		0, 190, 191, 1, 0, 0, 0, 191, 193, 1, 0, 0, 0, 192, 190, 1, 0, 0, 0, 193,
+		194, 5, 55, 0, 0, 194, 195, 3, 126, 63, 0, 195, 9, 1, 0, 0, 0, 196, 197,
+		5, 45, 0, 0, 197, 198, 5, 49, 0, 0, 198, 199, 5, 45, 0, 0, 199, 11, 1,
+		0, 0, 0, 200, 204, 3, 14, 7, 0, 201, 203, 3, 22, 11, 0, 202, 201, 1, 0,
+		0, 0, 203, 206, 1, 0, 0, 0, 204, 202, 1, 0, 0, 0, 204, 205, 1, 0, 0, 0,
+		205, 208, 1, 0, 0, 0, 206, 204, 1, 0, 0, 0, 207, 209, 3, 84, 42, 0, 208,
+		207, 1, 0, 0, 0, 208, 209, 1, 0, 0, 0, 209, 13, 1, 0, 0, 0, 210, 211, 5,
+		18, 0, 0, 211, 212, 5, 45, 0, 0, 212, 213, 3, 126, 63, 0, 213, 15, 1, 0,
+		0, 0, 214, 224, 5, 13, 0, 0, 215, 225, 3, 18, 9, 0, 216, 220, 5, 52, 0,
+		0, 217, 219, 3, 18, 9, 0, 218, 217, 1, 0, 0, 0, 219, 222, 1, 0, 0, 0, 220,
+		218, 1, 0, 0, 0, 220, 221, 1, 0, 0, 0, 221, 223, 1, 0, 0, 0, 222, 220,
+		1, 0, 0, 0, 223, 225, 5, 53, 0, 0, 224, 215, 1, 0, 0, 0, 224, 216, 1, 0,
+		0, 0, 225, 226, 1, 0, 0, 0, 226, 227, 3, 126, 63, 0, 227, 17, 1, 0, 0,
+		0, 228, 230, 7, 0, 0, 0, 229, 228, 1, 0, 0, 0, 229, 230, 1, 0, 0, 0, 230,
+		231, 1, 0, 0, 0, 231, 233, 3, 20, 10, 0, 232, 234, 5, 50, 0, 0, 233, 232,
+		1, 0, 0, 0, 233, 234, 1, 0, 0, 0, 234, 19, 1, 0, 0, 0, 235, 236, 3, 118,
+		59, 0, 236, 21, 1, 0, 0, 0, 237, 242, 3, 26, 13, 0, 238, 242, 3, 38, 19,
+		0, 239, 242, 3, 64, 32, 0, 240, 242, 3, 66, 33, 0, 241, 237, 1, 0, 0, 0,
+		241, 238, 1, 0, 0, 0, 241, 239, 1, 0, 0, 0, 241, 240, 1, 0, 0, 0, 242,
+		23, 1, 0, 0, 0, 243, 244, 7, 1, 0, 0, 244, 25, 1, 0, 0, 0, 245, 258, 5,
+		6, 0, 0, 246, 247, 3, 28, 14, 0, 247, 248, 3, 126, 63, 0, 248, 259, 1,
+		0, 0, 0, 249, 253, 5, 52, 0, 0, 250, 252, 3, 28, 14, 0, 251, 250, 1, 0,
+		0, 0, 252, 255, 1, 0, 0, 0, 253, 251, 1, 0, 0, 0, 253, 254, 1, 0, 0, 0,
+		254, 256, 1, 0, 0, 0, 255, 253, 1, 0, 0, 0, 256, 257, 5, 53, 0, 0, 257,
+		259, 3, 126, 63, 0, 258, 246, 1, 0, 0, 0, 258, 249, 1, 0, 0, 0, 259, 27,
+		1, 0, 0, 0, 260, 263, 3, 30, 15, 0, 261, 262, 5, 46, 0, 0, 262, 264, 3,
+		32, 16, 0, 263, 261, 1, 0, 0, 0, 263, 264, 1, 0, 0, 0, 264, 29, 1, 0, 0,
+		0, 265, 270, 3, 106, 53, 0, 266, 267, 5, 50, 0, 0, 267, 269, 3, 106, 53,
+		0, 268, 266, 1, 0, 0, 0, 269, 272, 1, 0, 0, 0, 270, 268, 1, 0, 0, 0, 270,
+		271, 1, 0, 0, 0, 271, 31, 1, 0, 0, 0, 272, 270, 1, 0, 0, 0, 273, 279, 3,
+		110, 55, 0, 274, 279, 3, 118, 59, 0, 275, 279, 3, 120, 60, 0, 276, 279,
+		3, 100, 50, 0, 277, 279, 3, 34, 17, 0, 278, 273, 1, 0, 0, 0, 278, 274,
+		1, 0, 0, 0, 278, 275, 1, 0, 0, 0, 278, 276, 1, 0, 0, 0, 278, 277, 1, 0,
+		0, 0, 279, 33, 1, 0, 0, 0, 280, 281, 5, 28, 0, 0, 281, 35, 1, 0, 0, 0,
+		282, 287, 3, 102, 51, 0, 283, 284, 5, 50, 0, 0, 284, 286, 3, 102, 51, 0,
+		285, 283, 1, 0, 0, 0, 286, 289, 1, 0, 0, 0, 287, 285, 1, 0, 0, 0, 287,
+		288, 1, 0, 0, 0, 288, 37, 1, 0, 0, 0, 289, 287, 1, 0, 0, 0, 290, 291, 5,
+		7, 0, 0, 291, 292, 5, 45, 0, 0, 292, 293, 5, 46, 0, 0, 293, 294, 3, 40,
+		20, 0, 294, 295, 3, 126, 63, 0, 295, 39, 1, 0, 0, 0, 296, 297, 5, 9, 0,
+		0, 297, 303, 5, 54, 0, 0, 298, 299, 3, 42, 21, 0, 299, 300, 5, 50, 0, 0,
+		300, 302, 1, 0, 0, 0, 301, 298, 1, 0, 0, 0, 302, 305, 1, 0, 0, 0, 303,
+		301, 1, 0, 0, 0, 303, 304, 1, 0, 0, 0, 304, 306, 1, 0, 0, 0, 305, 303,
+		1, 0, 0, 0, 306, 319, 5, 55, 0, 0, 307, 308, 5, 19, 0, 0, 308, 314, 5,
+		54, 0, 0, 309, 310, 3, 42, 21, 0, 310, 311, 5, 50, 0, 0, 311, 313, 1, 0,
+		0, 0, 312, 309, 1, 0, 0, 0, 313, 316, 1, 0, 0, 0, 314, 312, 1, 0, 0, 0,
+		314, 315, 1, 0, 0, 0, 315, 317, 1, 0, 0, 0, 316, 314, 1, 0, 0, 0, 317,
+		319, 5, 55, 0, 0, 318, 296, 1, 0, 0, 0, 318, 307, 1, 0, 0, 0, 319, 41,
+		1, 0, 0, 0, 320, 321, 5, 45, 0, 0, 321, 322, 5, 49, 0, 0, 322, 325, 3,
+		122, 61, 0, 323, 325, 3, 46, 23, 0, 324, 320, 1, 0, 0, 0, 324, 323, 1,
+		0, 0, 0, 325, 43, 1, 0, 0, 0, 326, 327, 5, 45, 0, 0, 327, 328, 5, 49, 0,
+		0, 328, 331, 3, 124, 62, 0, 329, 331, 3, 46, 23, 0, 330, 326, 1, 0, 0,
+		0, 330, 329, 1, 0, 0, 0, 331, 45, 1, 0, 0, 0, 332, 333, 5, 45, 0, 0, 333,
+		334, 5, 49, 0, 0, 334, 352, 3, 110, 55, 0, 335, 336, 5, 45, 0, 0, 336,
+		337, 5, 49, 0, 0, 337, 352, 3, 118, 59, 0, 338, 339, 5, 45, 0, 0, 339,
+		340, 5, 49, 0, 0, 340, 352, 3, 120, 60, 0, 341, 342, 5, 45, 0, 0, 342,
+		343, 5, 49, 0, 0, 343, 352, 3, 106, 53, 0, 344, 345, 5, 45, 0, 0, 345,
+		346, 5, 49, 0, 0, 346, 352, 3, 108, 54, 0, 347, 348, 5, 45, 0, 0, 348,
+		349, 5, 49, 0, 0, 349, 352, 3, 100, 50, 0, 350, 352, 5, 45, 0, 0, 351,
+		332, 1, 0, 0, 0, 351, 335, 1, 0, 0, 0, 351, 338, 1, 0, 0, 0, 351, 341,
+		1, 0, 0, 0, 351, 344, 1, 0, 0, 0, 351, 347, 1, 0, 0, 0, 351, 350, 1, 0,
+		0, 0, 352, 47, 1, 0, 0, 0, 353, 354, 5, 14, 0, 0, 354, 355, 3, 104, 52,
+		0, 355, 356, 3, 126, 63, 0, 356, 49, 1, 0, 0, 0, 357, 359, 5, 54, 0, 0,
+		358, 360, 3, 52, 26, 0, 359, 358, 1, 0, 0, 0, 359, 360, 1, 0, 0, 0, 360,
+		361, 1, 0, 0, 0, 361, 362, 5, 55, 0, 0, 362, 51, 1, 0, 0, 0, 363, 365,
+		3, 54, 27, 0, 364, 363, 1, 0, 0, 0, 365, 366, 1, 0, 0, 0, 366, 364, 1,
+		0, 0, 0, 366, 367, 1, 0, 0, 0, 367, 53, 1, 0, 0, 0, 368, 376, 3, 26, 13,
+		0, 369, 376, 3, 48, 24, 0, 370, 371, 3, 56, 28, 0, 371, 372, 3, 126, 63,
+		0, 372, 376, 1, 0, 0, 0, 373, 376, 3, 50, 25, 0, 374, 376, 3, 78, 39, 0,
+		375, 368, 1, 0, 0, 0, 375, 369, 1, 0, 0, 0, 375, 370, 1, 0, 0, 0, 375,
+		373, 1, 0, 0, 0, 375, 374, 1, 0, 0, 0, 376, 55, 1, 0, 0, 0, 377, 382, 3,
+		102, 51, 0, 378, 382, 3, 58, 29, 0, 379, 382, 3, 74, 37, 0, 380, 382, 3,
+		76, 38, 0, 381, 377, 1, 0, 0, 0, 381, 378, 1, 0, 0, 0, 381, 379, 1, 0,
+		0, 0, 381, 380, 1, 0, 0, 0, 382, 57, 1, 0, 0, 0, 383, 384, 3, 102, 51,
+		0, 384, 385, 7, 2, 0, 0, 385, 59, 1, 0, 0, 0, 386, 387, 6, 30, -1, 0, 387,
+		388, 5, 31, 0, 0, 388, 389, 5, 52, 0, 0, 389, 390, 3, 88, 44, 0, 390, 391,
+		5, 53, 0, 0, 391, 396, 1, 0, 0, 0, 392, 393, 5, 37, 0, 0, 393, 394, 5,
+		52, 0, 0, 394, 396, 5, 53, 0, 0, 395, 386, 1, 0, 0, 0, 395, 392, 1, 0,
+		0, 0, 396, 405, 1, 0, 0, 0, 397, 398, 10, 2, 0, 0, 398, 399, 5, 62, 0,
+		0, 399, 404, 3, 60, 30, 3, 400, 401, 10, 1, 0, 0, 401, 402, 5, 70, 0, 0,
+		402, 404, 3, 60, 30, 2, 403, 397, 1, 0, 0, 0, 403, 400, 1, 0, 0, 0, 404,
+		407, 1, 0, 0, 0, 405, 403, 1, 0, 0, 0, 405, 406, 1, 0, 0, 0, 406, 61, 1,
+		0, 0, 0, 407, 405, 1, 0, 0, 0, 408, 413, 3, 106, 53, 0, 409, 410, 5, 56,
+		0, 0, 410, 411, 3, 102, 51, 0, 411, 412, 5, 57, 0, 0, 412, 414, 1, 0, 0,
+		0, 413, 409, 1, 0, 0, 0, 414, 415, 1, 0, 0, 0, 415, 413, 1, 0, 0, 0, 415,
+		416, 1, 0, 0, 0, 416, 63, 1, 0, 0, 0, 417, 419, 5, 3, 0, 0, 418, 420, 3,
+		68, 34, 0, 419, 418, 1, 0, 0, 0, 419, 420, 1, 0, 0, 0, 420, 421, 1, 0,
+		0, 0, 421, 423, 3, 72, 36, 0, 422, 424, 3, 70, 35, 0, 423, 422, 1, 0, 0,
+		0, 423, 424, 1, 0, 0, 0, 424, 425, 1, 0, 0, 0, 425, 426, 3, 126, 63, 0,
+		426, 65, 1, 0, 0, 0, 427, 429, 5, 4, 0, 0, 428, 430, 3, 68, 34, 0, 429,
+		428, 1, 0, 0, 0, 429, 430, 1, 0, 0, 0, 430, 431, 1, 0, 0, 0, 431, 433,
+		3, 72, 36, 0, 432, 434, 3, 70, 35, 0, 433, 432, 1, 0, 0, 0, 433, 434, 1,
+		0, 0, 0, 434, 435, 1, 0, 0, 0, 435, 436, 3, 126, 63, 0, 436, 67, 1, 0,
+		0, 0, 437, 438, 7, 3, 0, 0, 438, 69, 1, 0, 0, 0, 439, 453, 7, 4, 0, 0,
+		440, 441, 7, 5, 0, 0, 441, 453, 3, 112, 56, 0, 442, 448, 5, 45, 0, 0, 443,
+		446, 3, 112, 56, 0, 444, 445, 5, 50, 0, 0, 445, 447, 3, 112, 56, 0, 446,
+		444, 1, 0, 0, 0, 446, 447, 1, 0, 0, 0, 447, 449, 1, 0, 0, 0, 448, 443,
+		1, 0, 0, 0, 448, 449, 1, 0, 0, 0, 449, 453, 1, 0, 0, 0, 450, 451, 5, 45,
+		0, 0, 451, 453, 3, 102, 51, 0, 452, 439, 1, 0, 0, 0, 452, 440, 1, 0, 0,
+		0, 452, 442, 1, 0, 0, 0, 452, 450, 1, 0, 0, 0, 453, 71, 1, 0, 0, 0, 454,
+		461, 3, 102, 51, 0, 455, 456, 5, 21, 0, 0, 456, 457, 3, 102, 51, 0, 457,
+		458, 5, 20, 0, 0, 458, 459, 3, 102, 51, 0, 459, 461, 1, 0, 0, 0, 460, 454,
+		1, 0, 0, 0, 460, 455, 1, 0, 0, 0, 461, 73, 1, 0, 0, 0, 462, 464, 3, 36,
+		18, 0, 463, 465, 7, 6, 0, 0, 464, 463, 1, 0, 0, 0, 464, 465, 1, 0, 0, 0,
+		465, 466, 1, 0, 0, 0, 466, 467, 5, 46, 0, 0, 467, 468, 3, 36, 18, 0, 468,
+		474, 1, 0, 0, 0, 469, 470, 3, 36, 18, 0, 470, 471, 7, 7, 0, 0, 471, 472,
+		3, 36, 18, 0, 472, 474, 1, 0, 0, 0, 473, 462, 1, 0, 0, 0, 473, 469, 1,
+		0, 0, 0, 474, 75, 1, 0, 0, 0, 475, 476, 5, 58, 0, 0, 476, 77, 1, 0, 0,
+		0, 477, 481, 5, 12, 0, 0, 478, 479, 3, 56, 28, 0, 479, 480, 5, 58, 0, 0,
+		480, 482, 1, 0, 0, 0, 481, 478, 1, 0, 0, 0, 481, 482, 1, 0, 0, 0, 482,
+		483, 1, 0, 0, 0, 483, 484, 3, 102, 51, 0, 484, 490, 3, 50, 25, 0, 485,
+		488, 5, 8, 0, 0, 486, 489, 3, 78, 39, 0, 487, 489, 3, 50, 25, 0, 488, 486,
+		1, 0, 0, 0, 488, 487, 1, 0, 0, 0, 489, 491, 1, 0, 0, 0, 490, 485, 1, 0,
+		0, 0, 490, 491, 1, 0, 0, 0, 491, 79, 1, 0, 0, 0, 492, 496, 5, 12, 0, 0,
+		493, 494, 3, 56, 28, 0, 494, 495, 5, 58, 0, 0, 495, 497, 1, 0, 0, 0, 496,
+		493, 1, 0, 0, 0, 496, 497, 1, 0, 0, 0, 497, 498, 1, 0, 0, 0, 498, 499,
+		3, 102, 51, 0, 499, 505, 3, 94, 47, 0, 500, 503, 5, 8, 0, 0, 501, 504,
+		3, 80, 40, 0, 502, 504, 3, 94, 47, 0, 503, 501, 1, 0, 0, 0, 503, 502, 1,
+		0, 0, 0, 504, 506, 1, 0, 0, 0, 505, 500, 1, 0, 0, 0, 505, 506, 1, 0, 0,
+		0, 506, 81, 1, 0, 0, 0, 507, 511, 5, 12, 0, 0, 508, 509, 3, 56, 28, 0,
+		509, 510, 5, 58, 0, 0, 510, 512, 1, 0, 0, 0, 511, 508, 1, 0, 0, 0, 511,
+		512, 1, 0, 0, 0, 512, 513, 1, 0, 0, 0, 513, 514, 3, 102, 51, 0, 514, 520,
+		3, 90, 45, 0, 515, 518, 5, 8, 0, 0, 516, 519, 3, 82, 41, 0, 517, 519, 3,
+		90, 45, 0, 518, 516, 1, 0, 0, 0, 518, 517, 1, 0, 0, 0, 519, 521, 1, 0,
+		0, 0, 520, 515, 1, 0, 0, 0, 520, 521, 1, 0, 0, 0, 521, 83, 1, 0, 0, 0,
+		522, 523, 5, 10, 0, 0, 523, 524, 3, 86, 43, 0, 524, 525, 5, 17, 0, 0, 525,
+		527, 3, 94, 47, 0, 526, 528, 3, 126, 63, 0, 527, 526, 1, 0, 0, 0, 527,
+		528, 1, 0, 0, 0, 528, 85, 1, 0, 0, 0, 529, 530, 3, 112, 56, 0, 530, 87,
+		1, 0, 0, 0, 531, 532, 7, 8, 0, 0, 532, 533, 5, 51, 0, 0, 533, 538, 5, 45,
+		0, 0, 534, 535, 5, 51, 0, 0, 535, 537, 5, 45, 0, 0, 536, 534, 1, 0, 0,
+		0, 537, 540, 1, 0, 0, 0, 538, 536, 1, 0, 0, 0, 538, 539, 1, 0, 0, 0, 539,
+		89, 1, 0, 0, 0, 540, 538, 1, 0, 0, 0, 541, 545, 5, 54, 0, 0, 542, 544,
+		3, 92, 46, 0, 543, 542, 1, 0, 0, 0, 544, 547, 1, 0, 0, 0, 545, 543, 1,
+		0, 0, 0, 545, 546, 1, 0, 0, 0, 546, 548, 1, 0, 0, 0, 547, 545, 1, 0, 0,
+		0, 548, 549, 5, 55, 0, 0, 549, 91, 1, 0, 0, 0, 550, 555, 3, 88, 44, 0,
+		551, 552, 5, 71, 0, 0, 552, 554, 3, 88, 44, 0, 553, 551, 1, 0, 0, 0, 554,
+		557, 1, 0, 0, 0, 555, 553, 1, 0, 0, 0, 555, 556, 1, 0, 0, 0, 556, 558,
+		1, 0, 0, 0, 557, 555, 1, 0, 0, 0, 558, 559, 3, 126, 63, 0, 559, 565, 1,
+		0, 0, 0, 560, 561, 3, 60, 30, 0, 561, 562, 3, 126, 63, 0, 562, 565, 1,
+		0, 0, 0, 563, 565, 3, 82, 41, 0, 564, 550, 1, 0, 0, 0, 564, 560, 1, 0,
+		0, 0, 564, 563, 1, 0, 0, 0, 565, 93, 1, 0, 0, 0, 566, 570, 5, 54, 0, 0,
+		567, 569, 3, 96, 48, 0, 568, 567, 1, 0, 0, 0, 569, 572, 1, 0, 0, 0, 570,
+		568, 1, 0, 0, 0, 570, 571, 1, 0, 0, 0, 571, 573, 1, 0, 0, 0, 572, 570,
+		1, 0, 0, 0, 573, 574, 5, 55, 0, 0, 574, 95, 1, 0, 0, 0, 575, 580, 3, 88,
+		44, 0, 576, 577, 5, 71, 0, 0, 577, 579, 3, 88, 44, 0, 578, 576, 1, 0, 0,
+		0, 579, 582, 1, 0, 0, 0, 580, 578, 1, 0, 0, 0, 580, 581, 1, 0, 0, 0, 581,
+		583, 1, 0, 0, 0, 582, 580, 1, 0, 0, 0, 583, 584, 3, 126, 63, 0, 584, 598,
+		1, 0, 0, 0, 585, 586, 5, 45, 0, 0, 586, 587, 5, 46, 0, 0, 587, 590, 5,
+		15, 0, 0, 588, 591, 3, 88, 44, 0, 589, 591, 5, 45, 0, 0, 590, 588, 1, 0,
+		0, 0, 590, 589, 1, 0, 0, 0, 591, 592, 1, 0, 0, 0, 592, 598, 3, 126, 63,
+		0, 593, 594, 3, 56, 28, 0, 594, 595, 3, 126, 63, 0, 595, 598, 1, 0, 0,
+		0, 596, 598, 3, 80, 40, 0, 597, 575, 1, 0, 0, 0, 597, 585, 1, 0, 0, 0,
+		597, 593, 1, 0, 0, 0, 597, 596, 1, 0, 0, 0, 598, 97, 1, 0, 0, 0, 599, 600,
+		7, 9, 0, 0, 600, 99, 1, 0, 0, 0, 601, 602, 3, 98, 49, 0, 602, 604, 5, 52,
+		0, 0, 603, 605, 3, 104, 52, 0, 604, 603, 1, 0, 0, 0, 604, 605, 1, 0, 0,
+		0, 605, 610, 1, 0, 0, 0, 606, 607, 5, 50, 0, 0, 607, 609, 3, 104, 52, 0,
+		608, 606, 1, 0, 0, 0, 609, 612, 1, 0, 0, 0, 610, 608, 1, 0, 0, 0, 610,
+		611, 1, 0, 0, 0, 611, 613, 1, 0, 0, 0, 612, 610, 1, 0, 0, 0, 613, 614,
+		5, 53, 0, 0, 614, 101, 1, 0, 0, 0, 615, 616, 6, 51, -1, 0, 616, 620, 3,
+		104, 52, 0, 617, 620, 3, 100, 50, 0, 618, 620, 3, 108, 54, 0, 619, 615,
+		1, 0, 0, 0, 619, 617, 1, 0, 0, 0, 619, 618, 1, 0, 0, 0, 620, 641, 1, 0,
+		0, 0, 621, 622, 10, 6, 0, 0, 622, 623, 5, 75, 0, 0, 623, 640, 3, 102, 51,
+		7, 624, 625, 10, 5, 0, 0, 625, 626, 7, 10, 0, 0, 626, 640, 3, 102, 51,
+		6, 627, 628, 10, 4, 0, 0, 628, 629, 7, 11, 0, 0, 629, 640, 3, 102, 51,
+		5, 630, 631, 10, 3, 0, 0, 631, 632, 7, 1, 0, 0, 632, 640, 3, 102, 51, 4,
+		633, 634, 10, 2, 0, 0, 634, 635, 5, 62, 0, 0, 635, 640, 3, 102, 51, 3,
+		636, 637, 10, 1, 0, 0, 637, 638, 5, 70, 0, 0, 638, 640, 3, 102, 51, 2,
+		639, 621, 1, 0, 0, 0, 639, 624, 1, 0, 0, 0, 639, 627, 1, 0, 0, 0, 639,
+		630, 1, 0, 0, 0, 639, 633, 1, 0, 0, 0, 639, 636, 1, 0, 0, 0, 640, 643,
+		1, 0, 0, 0, 641, 639, 1, 0, 0, 0, 641, 642, 1, 0, 0, 0, 642, 103, 1, 0,
+		0, 0, 643, 641, 1, 0, 0, 0, 644, 655, 3, 34, 17, 0, 645, 655, 3, 110, 55,
+		0, 646, 655, 3, 118, 59, 0, 647, 655, 3, 120, 60, 0, 648, 655, 3, 106,
+		53, 0, 649, 655, 3, 62, 31, 0, 650, 651, 5, 52, 0, 0, 651, 652, 3, 102,
+		51, 0, 652, 653, 5, 53, 0, 0, 653, 655, 1, 0, 0, 0, 654, 644, 1, 0, 0,
+		0, 654, 645, 1, 0, 0, 0, 654, 646, 1, 0, 0, 0, 654, 647, 1, 0, 0, 0, 654,
+		648, 1, 0, 0, 0, 654, 649, 1, 0, 0, 0, 654, 650, 1, 0, 0, 0, 655, 105,
+		1, 0, 0, 0, 656, 667, 5, 45, 0, 0, 657, 667, 3, 88, 44, 0, 658, 667, 5,
+		22, 0, 0, 659, 667, 5, 5, 0, 0, 660, 661, 5, 15, 0, 0, 661, 664, 5, 45,
+		0, 0, 662, 663, 5, 51, 0, 0, 663, 665, 5, 45, 0, 0, 664, 662, 1, 0, 0,
+		0, 664, 665, 1, 0, 0, 0, 665, 667, 1, 0, 0, 0, 666, 656, 1, 0, 0, 0, 666,
+		657, 1, 0, 0, 0, 666, 658, 1, 0, 0, 0, 666, 659, 1, 0, 0, 0, 666, 660,
+		1, 0, 0, 0, 667, 107, 1, 0, 0, 0, 668, 672, 1, 0, 0, 0, 669, 670, 7, 12,
+		0, 0, 670, 672, 3, 102, 51, 0, 671, 668, 1, 0, 0, 0, 671, 669, 1, 0, 0,
+		0, 672, 109, 1, 0, 0, 0, 673, 677, 3, 112, 56, 0, 674, 677, 3, 114, 57,
+		0, 675, 677, 3, 116, 58, 0, 676, 673, 1, 0, 0, 0, 676, 674, 1, 0, 0, 0,
+		676, 675, 1, 0, 0, 0, 677, 111, 1, 0, 0, 0, 678, 679, 7, 13, 0, 0, 679,
+		113, 1, 0, 0, 0, 680, 681, 5, 73, 0, 0, 681, 685, 3, 112, 56, 0, 682, 683,
+		5, 73, 0, 0, 683, 685, 3, 116, 58, 0, 684, 680, 1, 0, 0, 0, 684, 682, 1,
+		0, 0, 0, 685, 115, 1, 0, 0, 0, 686, 687, 5, 85, 0, 0, 687, 117, 1, 0, 0,
+		0, 688, 689, 7, 14, 0, 0, 689, 119, 1, 0, 0, 0, 690, 691, 7, 15, 0, 0,
+		691, 121, 1, 0, 0, 0, 692, 693, 5, 11, 0, 0, 693, 694, 3, 50, 25, 0, 694,
+		123, 1, 0, 0, 0, 695, 696, 5, 11, 0, 0, 696, 697, 3, 90, 45, 0, 697, 125,
+		1, 0, 0, 0, 698, 699, 5, 58, 0, 0, 699, 127, 1, 0, 0, 0, 74, 132, 138,
+		144, 149, 152, 155, 177, 190, 204, 208, 220, 224, 229, 233, 241, 253, 258,
+		263, 270, 278, 287, 303, 314, 318, 324, 330, 351, 359, 366, 375, 381, 395,
+		403, 405, 415, 419, 423, 429, 433, 446, 448, 452, 460, 464, 473, 481, 488,
+		490, 496, 503, 505, 511, 518, 520, 527, 538, 545, 555, 564, 570, 580, 590,
+		597, 604, 610, 619, 639, 641, 654, 664, 666, 671, 676, 684,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -424,95 +434,96 @@ func NewFaultParser(input antlr.TokenStream) *FaultParser {
 const (
 	FaultParserEOF                    = antlr.TokenEOF
 	FaultParserALL                    = 1
-	FaultParserASSERT                 = 2
-	FaultParserASSUME                 = 3
-	FaultParserCLOCK                  = 4
-	FaultParserCONST                  = 5
-	FaultParserDEF                    = 6
-	FaultParserELSE                   = 7
-	FaultParserFLOW                   = 8
-	FaultParserFOR                    = 9
-	FaultParserFUNC                   = 10
-	FaultParserIF                     = 11
-	FaultParserIMPORT                 = 12
-	FaultParserINIT                   = 13
-	FaultParserNEW                    = 14
-	FaultParserRETURN                 = 15
-	FaultParserRUN                    = 16
-	FaultParserSPEC                   = 17
-	FaultParserSTOCK                  = 18
-	FaultParserTHEN                   = 19
-	FaultParserWHEN                   = 20
-	FaultParserTHIS                   = 21
-	FaultParserEVENTUALLY             = 22
-	FaultParserEVENTUALLYALWAYS       = 23
-	FaultParserALWAYS                 = 24
-	FaultParserNMT                    = 25
-	FaultParserNFT                    = 26
-	FaultParserNIL                    = 27
-	FaultParserTRUE                   = 28
-	FaultParserFALSE                  = 29
-	FaultParserADVANCE                = 30
-	FaultParserCOMPONENT              = 31
-	FaultParserGLOBAL                 = 32
-	FaultParserSYSTEM                 = 33
-	FaultParserSTART                  = 34
-	FaultParserSTATE                  = 35
-	FaultParserSTAY                   = 36
-	FaultParserTY_STRING              = 37
-	FaultParserTY_BOOL                = 38
-	FaultParserTY_INT                 = 39
-	FaultParserTY_FLOAT               = 40
-	FaultParserTY_NATURAL             = 41
-	FaultParserTY_UNCERTAIN           = 42
-	FaultParserTY_UNKNOWN             = 43
-	FaultParserIDENT                  = 44
-	FaultParserASSIGN                 = 45
-	FaultParserASSIGN_FLOW1           = 46
-	FaultParserASSIGN_FLOW2           = 47
-	FaultParserCOLON                  = 48
-	FaultParserCOMMA                  = 49
-	FaultParserDOT                    = 50
-	FaultParserLPAREN                 = 51
-	FaultParserRPAREN                 = 52
-	FaultParserLCURLY                 = 53
-	FaultParserRCURLY                 = 54
-	FaultParserLBRACE                 = 55
-	FaultParserRBRACE                 = 56
-	FaultParserSEMI                   = 57
-	FaultParserPLUS_PLUS              = 58
-	FaultParserMINUS_MINUS            = 59
-	FaultParserAMPERSAND              = 60
-	FaultParserAND                    = 61
-	FaultParserBANG                   = 62
-	FaultParserEQUALS                 = 63
-	FaultParserNOT_EQUALS             = 64
-	FaultParserLESS                   = 65
-	FaultParserLESS_OR_EQUALS         = 66
-	FaultParserGREATER                = 67
-	FaultParserGREATER_OR_EQUALS      = 68
-	FaultParserOR                     = 69
-	FaultParserPIPE                   = 70
-	FaultParserPLUS                   = 71
-	FaultParserMINUS                  = 72
-	FaultParserCARET                  = 73
-	FaultParserEXPO                   = 74
-	FaultParserMULTI                  = 75
-	FaultParserDIV                    = 76
-	FaultParserMOD                    = 77
-	FaultParserLSHIFT                 = 78
-	FaultParserRSHIFT                 = 79
-	FaultParserBIT_CLEAR              = 80
-	FaultParserDECIMAL_LIT            = 81
-	FaultParserOCTAL_LIT              = 82
-	FaultParserHEX_LIT                = 83
-	FaultParserFLOAT_LIT              = 84
-	FaultParserRAW_STRING_LIT         = 85
-	FaultParserINTERPRETED_STRING_LIT = 86
-	FaultParserWS                     = 87
-	FaultParserCOMMENT                = 88
-	FaultParserTERMINATOR             = 89
-	FaultParserLINE_COMMENT           = 90
+	FaultParserANY                    = 2
+	FaultParserASSERT                 = 3
+	FaultParserASSUME                 = 4
+	FaultParserCLOCK                  = 5
+	FaultParserCONST                  = 6
+	FaultParserDEF                    = 7
+	FaultParserELSE                   = 8
+	FaultParserFLOW                   = 9
+	FaultParserFOR                    = 10
+	FaultParserFUNC                   = 11
+	FaultParserIF                     = 12
+	FaultParserIMPORT                 = 13
+	FaultParserINIT                   = 14
+	FaultParserNEW                    = 15
+	FaultParserRETURN                 = 16
+	FaultParserRUN                    = 17
+	FaultParserSPEC                   = 18
+	FaultParserSTOCK                  = 19
+	FaultParserTHEN                   = 20
+	FaultParserWHEN                   = 21
+	FaultParserTHIS                   = 22
+	FaultParserEVENTUALLY             = 23
+	FaultParserEVENTUALLYALWAYS       = 24
+	FaultParserALWAYS                 = 25
+	FaultParserNMT                    = 26
+	FaultParserNFT                    = 27
+	FaultParserNIL                    = 28
+	FaultParserTRUE                   = 29
+	FaultParserFALSE                  = 30
+	FaultParserADVANCE                = 31
+	FaultParserCOMPONENT              = 32
+	FaultParserGLOBAL                 = 33
+	FaultParserSYSTEM                 = 34
+	FaultParserSTART                  = 35
+	FaultParserSTATE                  = 36
+	FaultParserSTAY                   = 37
+	FaultParserTY_STRING              = 38
+	FaultParserTY_BOOL                = 39
+	FaultParserTY_INT                 = 40
+	FaultParserTY_FLOAT               = 41
+	FaultParserTY_NATURAL             = 42
+	FaultParserTY_UNCERTAIN           = 43
+	FaultParserTY_UNKNOWN             = 44
+	FaultParserIDENT                  = 45
+	FaultParserASSIGN                 = 46
+	FaultParserASSIGN_FLOW1           = 47
+	FaultParserASSIGN_FLOW2           = 48
+	FaultParserCOLON                  = 49
+	FaultParserCOMMA                  = 50
+	FaultParserDOT                    = 51
+	FaultParserLPAREN                 = 52
+	FaultParserRPAREN                 = 53
+	FaultParserLCURLY                 = 54
+	FaultParserRCURLY                 = 55
+	FaultParserLBRACE                 = 56
+	FaultParserRBRACE                 = 57
+	FaultParserSEMI                   = 58
+	FaultParserPLUS_PLUS              = 59
+	FaultParserMINUS_MINUS            = 60
+	FaultParserAMPERSAND              = 61
+	FaultParserAND                    = 62
+	FaultParserBANG                   = 63
+	FaultParserEQUALS                 = 64
+	FaultParserNOT_EQUALS             = 65
+	FaultParserLESS                   = 66
+	FaultParserLESS_OR_EQUALS         = 67
+	FaultParserGREATER                = 68
+	FaultParserGREATER_OR_EQUALS      = 69
+	FaultParserOR                     = 70
+	FaultParserPIPE                   = 71
+	FaultParserPLUS                   = 72
+	FaultParserMINUS                  = 73
+	FaultParserCARET                  = 74
+	FaultParserEXPO                   = 75
+	FaultParserMULTI                  = 76
+	FaultParserDIV                    = 77
+	FaultParserMOD                    = 78
+	FaultParserLSHIFT                 = 79
+	FaultParserRSHIFT                 = 80
+	FaultParserBIT_CLEAR              = 81
+	FaultParserDECIMAL_LIT            = 82
+	FaultParserOCTAL_LIT              = 83
+	FaultParserHEX_LIT                = 84
+	FaultParserFLOAT_LIT              = 85
+	FaultParserRAW_STRING_LIT         = 86
+	FaultParserINTERPRETED_STRING_LIT = 87
+	FaultParserWS                     = 88
+	FaultParserCOMMENT                = 89
+	FaultParserTERMINATOR             = 90
+	FaultParserLINE_COMMENT           = 91
 )
 
 // FaultParser rules.
@@ -551,35 +562,36 @@ const (
 	FaultParserRULE_accessHistory    = 31
 	FaultParserRULE_assertion        = 32
 	FaultParserRULE_assumption       = 33
-	FaultParserRULE_temporal         = 34
-	FaultParserRULE_invariant        = 35
-	FaultParserRULE_assignment       = 36
-	FaultParserRULE_emptyStmt        = 37
-	FaultParserRULE_ifStmt           = 38
-	FaultParserRULE_ifStmtRun        = 39
-	FaultParserRULE_ifStmtState      = 40
-	FaultParserRULE_forStmt          = 41
-	FaultParserRULE_rounds           = 42
-	FaultParserRULE_paramCall        = 43
-	FaultParserRULE_stateBlock       = 44
-	FaultParserRULE_stateStep        = 45
-	FaultParserRULE_runBlock         = 46
-	FaultParserRULE_runStep          = 47
-	FaultParserRULE_faultType        = 48
-	FaultParserRULE_solvable         = 49
-	FaultParserRULE_expression       = 50
-	FaultParserRULE_operand          = 51
-	FaultParserRULE_operandName      = 52
-	FaultParserRULE_prefix           = 53
-	FaultParserRULE_numeric          = 54
-	FaultParserRULE_integer          = 55
-	FaultParserRULE_negative         = 56
-	FaultParserRULE_float_           = 57
-	FaultParserRULE_string_          = 58
-	FaultParserRULE_bool_            = 59
-	FaultParserRULE_functionLit      = 60
-	FaultParserRULE_stateLit         = 61
-	FaultParserRULE_eos              = 62
+	FaultParserRULE_quantifier       = 34
+	FaultParserRULE_temporal         = 35
+	FaultParserRULE_invariant        = 36
+	FaultParserRULE_assignment       = 37
+	FaultParserRULE_emptyStmt        = 38
+	FaultParserRULE_ifStmt           = 39
+	FaultParserRULE_ifStmtRun        = 40
+	FaultParserRULE_ifStmtState      = 41
+	FaultParserRULE_forStmt          = 42
+	FaultParserRULE_rounds           = 43
+	FaultParserRULE_paramCall        = 44
+	FaultParserRULE_stateBlock       = 45
+	FaultParserRULE_stateStep        = 46
+	FaultParserRULE_runBlock         = 47
+	FaultParserRULE_runStep          = 48
+	FaultParserRULE_faultType        = 49
+	FaultParserRULE_solvable         = 50
+	FaultParserRULE_expression       = 51
+	FaultParserRULE_operand          = 52
+	FaultParserRULE_operandName      = 53
+	FaultParserRULE_prefix           = 54
+	FaultParserRULE_numeric          = 55
+	FaultParserRULE_integer          = 56
+	FaultParserRULE_negative         = 57
+	FaultParserRULE_float_           = 58
+	FaultParserRULE_string_          = 59
+	FaultParserRULE_bool_            = 60
+	FaultParserRULE_functionLit      = 61
+	FaultParserRULE_stateLit         = 62
+	FaultParserRULE_eos              = 63
 )
 
 // ISysSpecContext is an interface to support dynamic dispatch.
@@ -879,64 +891,64 @@ func (p *FaultParser) SysSpec() (localctx ISysSpecContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(126)
+		p.SetState(128)
 		p.SysClause()
 	}
-	p.SetState(130)
+	p.SetState(132)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserIMPORT {
 		{
-			p.SetState(127)
+			p.SetState(129)
 			p.ImportDecl()
 		}
 
-		p.SetState(132)
+		p.SetState(134)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(136)
+	p.SetState(138)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserGLOBAL {
 		{
-			p.SetState(133)
+			p.SetState(135)
 			p.GlobalDecl()
 		}
 
-		p.SetState(138)
+		p.SetState(140)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(142)
+	p.SetState(144)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserCOMPONENT {
 		{
-			p.SetState(139)
+			p.SetState(141)
 			p.ComponentDecl()
 		}
 
-		p.SetState(144)
+		p.SetState(146)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(147)
+	p.SetState(149)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserASSERT:
 		{
-			p.SetState(145)
+			p.SetState(147)
 			p.Assertion()
 		}
 
 	case FaultParserASSUME:
 		{
-			p.SetState(146)
+			p.SetState(148)
 			p.Assumption()
 		}
 
@@ -944,24 +956,24 @@ func (p *FaultParser) SysSpec() (localctx ISysSpecContext) {
 
 	default:
 	}
-	p.SetState(150)
+	p.SetState(152)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserSTART {
 		{
-			p.SetState(149)
+			p.SetState(151)
 			p.StartBlock()
 		}
 
 	}
-	p.SetState(153)
+	p.SetState(155)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserFOR {
 		{
-			p.SetState(152)
+			p.SetState(154)
 			p.ForStmt()
 		}
 
@@ -1087,15 +1099,15 @@ func (p *FaultParser) SysClause() (localctx ISysClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(155)
+		p.SetState(157)
 		p.Match(FaultParserSYSTEM)
 	}
 	{
-		p.SetState(156)
+		p.SetState(158)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(157)
+		p.SetState(159)
 		p.Eos()
 	}
 
@@ -1239,23 +1251,23 @@ func (p *FaultParser) GlobalDecl() (localctx IGlobalDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(159)
+		p.SetState(161)
 		p.Match(FaultParserGLOBAL)
 	}
 	{
-		p.SetState(160)
+		p.SetState(162)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(161)
+		p.SetState(163)
 		p.Match(FaultParserASSIGN)
 	}
 	{
-		p.SetState(162)
+		p.SetState(164)
 		p.Operand()
 	}
 	{
-		p.SetState(163)
+		p.SetState(165)
 		p.Eos()
 	}
 
@@ -1445,49 +1457,49 @@ func (p *FaultParser) ComponentDecl() (localctx IComponentDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(165)
+		p.SetState(167)
 		p.Match(FaultParserCOMPONENT)
 	}
 	{
-		p.SetState(166)
+		p.SetState(168)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(167)
+		p.SetState(169)
 		p.Match(FaultParserASSIGN)
 	}
 	{
-		p.SetState(168)
+		p.SetState(170)
 		p.Match(FaultParserSTATE)
 	}
 	{
-		p.SetState(169)
+		p.SetState(171)
 		p.Match(FaultParserLCURLY)
 	}
-	p.SetState(175)
+	p.SetState(177)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserIDENT {
 		{
-			p.SetState(170)
+			p.SetState(172)
 			p.ComProperties()
 		}
 		{
-			p.SetState(171)
+			p.SetState(173)
 			p.Match(FaultParserCOMMA)
 		}
 
-		p.SetState(177)
+		p.SetState(179)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(178)
+		p.SetState(180)
 		p.Match(FaultParserRCURLY)
 	}
 	{
-		p.SetState(179)
+		p.SetState(181)
 		p.Eos()
 	}
 
@@ -1665,37 +1677,37 @@ func (p *FaultParser) StartBlock() (localctx IStartBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(181)
+		p.SetState(183)
 		p.Match(FaultParserSTART)
 	}
 	{
-		p.SetState(182)
+		p.SetState(184)
 		p.Match(FaultParserLCURLY)
 	}
-	p.SetState(188)
+	p.SetState(190)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserIDENT {
 		{
-			p.SetState(183)
+			p.SetState(185)
 			p.StartPair()
 		}
 		{
-			p.SetState(184)
+			p.SetState(186)
 			p.Match(FaultParserCOMMA)
 		}
 
-		p.SetState(190)
+		p.SetState(192)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(191)
+		p.SetState(193)
 		p.Match(FaultParserRCURLY)
 	}
 	{
-		p.SetState(192)
+		p.SetState(194)
 		p.Eos()
 	}
 
@@ -1807,15 +1819,15 @@ func (p *FaultParser) StartPair() (localctx IStartPairContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(194)
+		p.SetState(196)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(195)
+		p.SetState(197)
 		p.Match(FaultParserCOLON)
 	}
 	{
-		p.SetState(196)
+		p.SetState(198)
 		p.Match(FaultParserIDENT)
 	}
 
@@ -1989,30 +2001,30 @@ func (p *FaultParser) Spec() (localctx ISpecContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(198)
+		p.SetState(200)
 		p.SpecClause()
 	}
-	p.SetState(202)
+	p.SetState(204)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&108) != 0 {
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&216) != 0 {
 		{
-			p.SetState(199)
+			p.SetState(201)
 			p.Declaration()
 		}
 
-		p.SetState(204)
+		p.SetState(206)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(206)
+	p.SetState(208)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserFOR {
 		{
-			p.SetState(205)
+			p.SetState(207)
 			p.ForStmt()
 		}
 
@@ -2138,15 +2150,15 @@ func (p *FaultParser) SpecClause() (localctx ISpecClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(208)
+		p.SetState(210)
 		p.Match(FaultParserSPEC)
 	}
 	{
-		p.SetState(209)
+		p.SetState(211)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(210)
+		p.SetState(212)
 		p.Eos()
 	}
 
@@ -2316,40 +2328,40 @@ func (p *FaultParser) ImportDecl() (localctx IImportDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(212)
+		p.SetState(214)
 		p.Match(FaultParserIMPORT)
 	}
-	p.SetState(222)
+	p.SetState(224)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserIDENT, FaultParserDOT, FaultParserRAW_STRING_LIT, FaultParserINTERPRETED_STRING_LIT:
 		{
-			p.SetState(213)
+			p.SetState(215)
 			p.ImportSpec()
 		}
 
 	case FaultParserLPAREN:
 		{
-			p.SetState(214)
+			p.SetState(216)
 			p.Match(FaultParserLPAREN)
 		}
-		p.SetState(218)
+		p.SetState(220)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		for (int64((_la-44)) & ^0x3f) == 0 && ((int64(1)<<(_la-44))&6597069766721) != 0 {
+		for (int64((_la-45)) & ^0x3f) == 0 && ((int64(1)<<(_la-45))&6597069766721) != 0 {
 			{
-				p.SetState(215)
+				p.SetState(217)
 				p.ImportSpec()
 			}
 
-			p.SetState(220)
+			p.SetState(222)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(221)
+			p.SetState(223)
 			p.Match(FaultParserRPAREN)
 		}
 
@@ -2357,7 +2369,7 @@ func (p *FaultParser) ImportDecl() (localctx IImportDeclContext) {
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(224)
+		p.SetState(226)
 		p.Eos()
 	}
 
@@ -2485,13 +2497,13 @@ func (p *FaultParser) ImportSpec() (localctx IImportSpecContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(227)
+	p.SetState(229)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserIDENT || _la == FaultParserDOT {
 		{
-			p.SetState(226)
+			p.SetState(228)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == FaultParserIDENT || _la == FaultParserDOT) {
@@ -2504,16 +2516,16 @@ func (p *FaultParser) ImportSpec() (localctx IImportSpecContext) {
 
 	}
 	{
-		p.SetState(229)
+		p.SetState(231)
 		p.ImportPath()
 	}
-	p.SetState(231)
+	p.SetState(233)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserCOMMA {
 		{
-			p.SetState(230)
+			p.SetState(232)
 			p.Match(FaultParserCOMMA)
 		}
 
@@ -2631,7 +2643,7 @@ func (p *FaultParser) ImportPath() (localctx IImportPathContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(233)
+		p.SetState(235)
 		p.String_()
 	}
 
@@ -2793,35 +2805,35 @@ func (p *FaultParser) Declaration() (localctx IDeclarationContext) {
 		}
 	}()
 
-	p.SetState(239)
+	p.SetState(241)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserCONST:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(235)
+			p.SetState(237)
 			p.ConstDecl()
 		}
 
 	case FaultParserDEF:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(236)
+			p.SetState(238)
 			p.StructDecl()
 		}
 
 	case FaultParserASSERT:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(237)
+			p.SetState(239)
 			p.Assertion()
 		}
 
 	case FaultParserASSUME:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(238)
+			p.SetState(240)
 			p.Assumption()
 		}
 
@@ -2950,10 +2962,10 @@ func (p *FaultParser) Comparison() (localctx IComparisonContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(241)
+		p.SetState(243)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-63)) & ^0x3f) == 0 && ((int64(1)<<(_la-63))&63) != 0) {
+		if !((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&63) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -3127,48 +3139,48 @@ func (p *FaultParser) ConstDecl() (localctx IConstDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(243)
+		p.SetState(245)
 		p.Match(FaultParserCONST)
 	}
-	p.SetState(256)
+	p.SetState(258)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserCLOCK, FaultParserNEW, FaultParserTHIS, FaultParserIDENT:
 		{
-			p.SetState(244)
+			p.SetState(246)
 			p.ConstSpec()
 		}
 		{
-			p.SetState(245)
+			p.SetState(247)
 			p.Eos()
 		}
 
 	case FaultParserLPAREN:
 		{
-			p.SetState(247)
+			p.SetState(249)
 			p.Match(FaultParserLPAREN)
 		}
-		p.SetState(251)
+		p.SetState(253)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17592188157968) != 0 {
+		for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&35184376315936) != 0 {
 			{
-				p.SetState(248)
+				p.SetState(250)
 				p.ConstSpec()
 			}
 
-			p.SetState(253)
+			p.SetState(255)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(254)
+			p.SetState(256)
 			p.Match(FaultParserRPAREN)
 		}
 		{
-			p.SetState(255)
+			p.SetState(257)
 			p.Eos()
 		}
 
@@ -3309,20 +3321,20 @@ func (p *FaultParser) ConstSpec() (localctx IConstSpecContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(258)
+		p.SetState(260)
 		p.IdentList()
 	}
-	p.SetState(261)
+	p.SetState(263)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserASSIGN {
 		{
-			p.SetState(259)
+			p.SetState(261)
 			p.Match(FaultParserASSIGN)
 		}
 		{
-			p.SetState(260)
+			p.SetState(262)
 			p.Constants()
 		}
 
@@ -3474,24 +3486,24 @@ func (p *FaultParser) IdentList() (localctx IIdentListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(263)
+		p.SetState(265)
 		p.OperandName()
 	}
-	p.SetState(268)
+	p.SetState(270)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserCOMMA {
 		{
-			p.SetState(264)
+			p.SetState(266)
 			p.Match(FaultParserCOMMA)
 		}
 		{
-			p.SetState(265)
+			p.SetState(267)
 			p.OperandName()
 		}
 
-		p.SetState(270)
+		p.SetState(272)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -3670,42 +3682,42 @@ func (p *FaultParser) Constants() (localctx IConstantsContext) {
 		}
 	}()
 
-	p.SetState(276)
+	p.SetState(278)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserMINUS, FaultParserDECIMAL_LIT, FaultParserOCTAL_LIT, FaultParserHEX_LIT, FaultParserFLOAT_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(271)
+			p.SetState(273)
 			p.Numeric()
 		}
 
 	case FaultParserRAW_STRING_LIT, FaultParserINTERPRETED_STRING_LIT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(272)
+			p.SetState(274)
 			p.String_()
 		}
 
 	case FaultParserTRUE, FaultParserFALSE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(273)
+			p.SetState(275)
 			p.Bool_()
 		}
 
 	case FaultParserTY_STRING, FaultParserTY_BOOL, FaultParserTY_INT, FaultParserTY_FLOAT, FaultParserTY_NATURAL, FaultParserTY_UNCERTAIN, FaultParserTY_UNKNOWN:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(274)
+			p.SetState(276)
 			p.Solvable()
 		}
 
 	case FaultParserNIL:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(275)
+			p.SetState(277)
 			p.Nil_()
 		}
 
@@ -3813,7 +3825,7 @@ func (p *FaultParser) Nil_() (localctx INilContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(278)
+		p.SetState(280)
 		p.Match(FaultParserNIL)
 	}
 
@@ -3963,24 +3975,24 @@ func (p *FaultParser) ExpressionList() (localctx IExpressionListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(280)
+		p.SetState(282)
 		p.expression(0)
 	}
-	p.SetState(285)
+	p.SetState(287)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserCOMMA {
 		{
-			p.SetState(281)
+			p.SetState(283)
 			p.Match(FaultParserCOMMA)
 		}
 		{
-			p.SetState(282)
+			p.SetState(284)
 			p.expression(0)
 		}
 
-		p.SetState(287)
+		p.SetState(289)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -4125,23 +4137,23 @@ func (p *FaultParser) StructDecl() (localctx IStructDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(288)
+		p.SetState(290)
 		p.Match(FaultParserDEF)
 	}
 	{
-		p.SetState(289)
+		p.SetState(291)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(290)
+		p.SetState(292)
 		p.Match(FaultParserASSIGN)
 	}
 	{
-		p.SetState(291)
+		p.SetState(293)
 		p.StructType()
 	}
 	{
-		p.SetState(292)
+		p.SetState(294)
 		p.Eos()
 	}
 
@@ -4424,7 +4436,7 @@ func (p *FaultParser) StructType() (localctx IStructTypeContext) {
 		}
 	}()
 
-	p.SetState(316)
+	p.SetState(318)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
@@ -4432,33 +4444,33 @@ func (p *FaultParser) StructType() (localctx IStructTypeContext) {
 		localctx = NewFlowContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(294)
+			p.SetState(296)
 			p.Match(FaultParserFLOW)
 		}
 		{
-			p.SetState(295)
+			p.SetState(297)
 			p.Match(FaultParserLCURLY)
 		}
-		p.SetState(301)
+		p.SetState(303)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == FaultParserIDENT {
 			{
-				p.SetState(296)
+				p.SetState(298)
 				p.SfProperties()
 			}
 			{
-				p.SetState(297)
+				p.SetState(299)
 				p.Match(FaultParserCOMMA)
 			}
 
-			p.SetState(303)
+			p.SetState(305)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(304)
+			p.SetState(306)
 			p.Match(FaultParserRCURLY)
 		}
 
@@ -4466,33 +4478,33 @@ func (p *FaultParser) StructType() (localctx IStructTypeContext) {
 		localctx = NewStockContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(305)
+			p.SetState(307)
 			p.Match(FaultParserSTOCK)
 		}
 		{
-			p.SetState(306)
+			p.SetState(308)
 			p.Match(FaultParserLCURLY)
 		}
-		p.SetState(312)
+		p.SetState(314)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == FaultParserIDENT {
 			{
-				p.SetState(307)
+				p.SetState(309)
 				p.SfProperties()
 			}
 			{
-				p.SetState(308)
+				p.SetState(310)
 				p.Match(FaultParserCOMMA)
 			}
 
-			p.SetState(314)
+			p.SetState(316)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(315)
+			p.SetState(317)
 			p.Match(FaultParserRCURLY)
 		}
 
@@ -4696,22 +4708,22 @@ func (p *FaultParser) SfProperties() (localctx ISfPropertiesContext) {
 		}
 	}()
 
-	p.SetState(322)
+	p.SetState(324)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 24, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewPropFuncContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(318)
+			p.SetState(320)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(319)
+			p.SetState(321)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(320)
+			p.SetState(322)
 			p.FunctionLit()
 		}
 
@@ -4719,7 +4731,7 @@ func (p *FaultParser) SfProperties() (localctx ISfPropertiesContext) {
 		localctx = NewSfMiscContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(321)
+			p.SetState(323)
 			p.StructProperties()
 		}
 
@@ -4921,22 +4933,22 @@ func (p *FaultParser) ComProperties() (localctx IComPropertiesContext) {
 		}
 	}()
 
-	p.SetState(328)
+	p.SetState(330)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewStateFuncContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(324)
+			p.SetState(326)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(325)
+			p.SetState(327)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(326)
+			p.SetState(328)
 			p.StateLit()
 		}
 
@@ -4944,7 +4956,7 @@ func (p *FaultParser) ComProperties() (localctx IComPropertiesContext) {
 		localctx = NewCompMiscContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(327)
+			p.SetState(329)
 			p.StructProperties()
 		}
 
@@ -5362,22 +5374,22 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		}
 	}()
 
-	p.SetState(349)
+	p.SetState(351)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewPropIntContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(330)
+			p.SetState(332)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(331)
+			p.SetState(333)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(332)
+			p.SetState(334)
 			p.Numeric()
 		}
 
@@ -5385,15 +5397,15 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		localctx = NewPropStringContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(333)
+			p.SetState(335)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(334)
+			p.SetState(336)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(335)
+			p.SetState(337)
 			p.String_()
 		}
 
@@ -5401,15 +5413,15 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		localctx = NewPropBoolContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(336)
+			p.SetState(338)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(337)
+			p.SetState(339)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(338)
+			p.SetState(340)
 			p.Bool_()
 		}
 
@@ -5417,15 +5429,15 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		localctx = NewPropVarContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(339)
+			p.SetState(341)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(340)
+			p.SetState(342)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(341)
+			p.SetState(343)
 			p.OperandName()
 		}
 
@@ -5433,15 +5445,15 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		localctx = NewPropVarContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(342)
+			p.SetState(344)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(343)
+			p.SetState(345)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(344)
+			p.SetState(346)
 			p.Prefix()
 		}
 
@@ -5449,15 +5461,15 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		localctx = NewPropSolvableContext(p, localctx)
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(345)
+			p.SetState(347)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(346)
+			p.SetState(348)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(347)
+			p.SetState(349)
 			p.Solvable()
 		}
 
@@ -5465,7 +5477,7 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		localctx = NewPropSolvableContext(p, localctx)
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(348)
+			p.SetState(350)
 			p.Match(FaultParserIDENT)
 		}
 
@@ -5603,15 +5615,15 @@ func (p *FaultParser) InitDecl() (localctx IInitDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(351)
+		p.SetState(353)
 		p.Match(FaultParserINIT)
 	}
 	{
-		p.SetState(352)
+		p.SetState(354)
 		p.Operand()
 	}
 	{
-		p.SetState(353)
+		p.SetState(355)
 		p.Eos()
 	}
 
@@ -5735,21 +5747,21 @@ func (p *FaultParser) Block() (localctx IBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(355)
+		p.SetState(357)
 		p.Match(FaultParserLCURLY)
 	}
-	p.SetState(357)
+	p.SetState(359)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 27, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(356)
+			p.SetState(358)
 			p.StatementList()
 		}
 
 	}
 	{
-		p.SetState(359)
+		p.SetState(361)
 		p.Match(FaultParserRCURLY)
 	}
 
@@ -5891,14 +5903,14 @@ func (p *FaultParser) StatementList() (localctx IStatementListContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(362)
+	p.SetState(364)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
 			{
-				p.SetState(361)
+				p.SetState(363)
 				p.Statement()
 			}
 
@@ -5906,7 +5918,7 @@ func (p *FaultParser) StatementList() (localctx IStatementListContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(364)
+		p.SetState(366)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext())
 	}
@@ -6101,45 +6113,45 @@ func (p *FaultParser) Statement() (localctx IStatementContext) {
 		}
 	}()
 
-	p.SetState(373)
+	p.SetState(375)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 29, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(366)
+			p.SetState(368)
 			p.ConstDecl()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(367)
+			p.SetState(369)
 			p.InitDecl()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(368)
+			p.SetState(370)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(369)
+			p.SetState(371)
 			p.Eos()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(371)
+			p.SetState(373)
 			p.Block()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(372)
+			p.SetState(374)
 			p.IfStmt()
 		}
 
@@ -6303,34 +6315,34 @@ func (p *FaultParser) SimpleStmt() (localctx ISimpleStmtContext) {
 		}
 	}()
 
-	p.SetState(379)
+	p.SetState(381)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 30, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(375)
+			p.SetState(377)
 			p.expression(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(376)
+			p.SetState(378)
 			p.IncDecStmt()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(377)
+			p.SetState(379)
 			p.Assignment()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(378)
+			p.SetState(380)
 			p.EmptyStmt()
 		}
 
@@ -6457,11 +6469,11 @@ func (p *FaultParser) IncDecStmt() (localctx IIncDecStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(381)
+		p.SetState(383)
 		p.expression(0)
 	}
 	{
-		p.SetState(382)
+		p.SetState(384)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == FaultParserPLUS_PLUS || _la == FaultParserMINUS_MINUS) {
@@ -6721,7 +6733,7 @@ func (p *FaultParser) stateChange(_p int) (localctx IStateChangeContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(393)
+	p.SetState(395)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
@@ -6731,19 +6743,19 @@ func (p *FaultParser) stateChange(_p int) (localctx IStateChangeContext) {
 		_prevctx = localctx
 
 		{
-			p.SetState(385)
+			p.SetState(387)
 			p.Match(FaultParserADVANCE)
 		}
 		{
-			p.SetState(386)
+			p.SetState(388)
 			p.Match(FaultParserLPAREN)
 		}
 		{
-			p.SetState(387)
+			p.SetState(389)
 			p.ParamCall()
 		}
 		{
-			p.SetState(388)
+			p.SetState(390)
 			p.Match(FaultParserRPAREN)
 		}
 
@@ -6752,15 +6764,15 @@ func (p *FaultParser) stateChange(_p int) (localctx IStateChangeContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(390)
+			p.SetState(392)
 			p.Match(FaultParserSTAY)
 		}
 		{
-			p.SetState(391)
+			p.SetState(393)
 			p.Match(FaultParserLPAREN)
 		}
 		{
-			p.SetState(392)
+			p.SetState(394)
 			p.Match(FaultParserRPAREN)
 		}
 
@@ -6768,7 +6780,7 @@ func (p *FaultParser) stateChange(_p int) (localctx IStateChangeContext) {
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(403)
+	p.SetState(405)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext())
 
@@ -6778,47 +6790,47 @@ func (p *FaultParser) stateChange(_p int) (localctx IStateChangeContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(401)
+			p.SetState(403)
 			p.GetErrorHandler().Sync(p)
 			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewBuiltinInfixContext(p, NewStateChangeContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_stateChange)
-				p.SetState(395)
+				p.SetState(397)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(396)
+					p.SetState(398)
 					p.Match(FaultParserAND)
 				}
 				{
-					p.SetState(397)
+					p.SetState(399)
 					p.stateChange(3)
 				}
 
 			case 2:
 				localctx = NewBuiltinInfixContext(p, NewStateChangeContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_stateChange)
-				p.SetState(398)
+				p.SetState(400)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				}
 				{
-					p.SetState(399)
+					p.SetState(401)
 					p.Match(FaultParserOR)
 				}
 				{
-					p.SetState(400)
+					p.SetState(402)
 					p.stateChange(2)
 				}
 
 			}
 
 		}
-		p.SetState(405)
+		p.SetState(407)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext())
 	}
@@ -6994,25 +7006,25 @@ func (p *FaultParser) AccessHistory() (localctx IAccessHistoryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(406)
+		p.SetState(408)
 		p.OperandName()
 	}
-	p.SetState(411)
+	p.SetState(413)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
 			{
-				p.SetState(407)
+				p.SetState(409)
 				p.Match(FaultParserLBRACE)
 			}
 			{
-				p.SetState(408)
+				p.SetState(410)
 				p.expression(0)
 			}
 			{
-				p.SetState(409)
+				p.SetState(411)
 				p.Match(FaultParserRBRACE)
 			}
 
@@ -7020,7 +7032,7 @@ func (p *FaultParser) AccessHistory() (localctx IAccessHistoryContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(413)
+		p.SetState(415)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext())
 	}
@@ -7102,6 +7114,22 @@ func (s *AssertionContext) Eos() IEosContext {
 	return t.(IEosContext)
 }
 
+func (s *AssertionContext) Quantifier() IQuantifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQuantifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQuantifierContext)
+}
+
 func (s *AssertionContext) Temporal() ITemporalContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -7174,26 +7202,36 @@ func (p *FaultParser) Assertion() (localctx IAssertionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(415)
+		p.SetState(417)
 		p.Match(FaultParserASSERT)
 	}
+	p.SetState(419)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(418)
+			p.Quantifier()
+		}
+
+	}
 	{
-		p.SetState(416)
+		p.SetState(421)
 		p.Invariant()
 	}
-	p.SetState(418)
+	p.SetState(423)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&130023424) != 0 {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&35184632135680) != 0 {
 		{
-			p.SetState(417)
+			p.SetState(422)
 			p.Temporal()
 		}
 
 	}
 	{
-		p.SetState(420)
+		p.SetState(425)
 		p.Eos()
 	}
 
@@ -7274,6 +7312,22 @@ func (s *AssumptionContext) Eos() IEosContext {
 	return t.(IEosContext)
 }
 
+func (s *AssumptionContext) Quantifier() IQuantifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQuantifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQuantifierContext)
+}
+
 func (s *AssumptionContext) Temporal() ITemporalContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -7346,32 +7400,158 @@ func (p *FaultParser) Assumption() (localctx IAssumptionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(422)
+		p.SetState(427)
 		p.Match(FaultParserASSUME)
 	}
+	p.SetState(429)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(428)
+			p.Quantifier()
+		}
+
+	}
 	{
-		p.SetState(423)
+		p.SetState(431)
 		p.Invariant()
 	}
-	p.SetState(425)
+	p.SetState(433)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&130023424) != 0 {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&35184632135680) != 0 {
 		{
-			p.SetState(424)
+			p.SetState(432)
 			p.Temporal()
 		}
 
 	}
 	{
-		p.SetState(427)
+		p.SetState(435)
 		p.Eos()
 	}
 
 	return localctx
 }
 
+// IQuantifierContext is an interface to support dynamic dispatch.
+type IQuantifierContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsQuantifierContext differentiates from other interfaces.
+	IsQuantifierContext()
+}
+
+type QuantifierContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyQuantifierContext() *QuantifierContext {
+	var p = new(QuantifierContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FaultParserRULE_quantifier
+	return p
+}
+
+func (*QuantifierContext) IsQuantifierContext() {}
+
+func NewQuantifierContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QuantifierContext {
+	var p = new(QuantifierContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FaultParserRULE_quantifier
+
+	return p
+}
+
+func (s *QuantifierContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *QuantifierContext) ALL() antlr.TerminalNode {
+	return s.GetToken(FaultParserALL, 0)
+}
+
+func (s *QuantifierContext) ANY() antlr.TerminalNode {
+	return s.GetToken(FaultParserANY, 0)
+}
+
+func (s *QuantifierContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *QuantifierContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *QuantifierContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FaultParserListener); ok {
+		listenerT.EnterQuantifier(s)
+	}
+}
+
+func (s *QuantifierContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FaultParserListener); ok {
+		listenerT.ExitQuantifier(s)
+	}
+}
+
+func (s *QuantifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FaultParserVisitor:
+		return t.VisitQuantifier(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FaultParser) Quantifier() (localctx IQuantifierContext) {
+	this := p
+	_ = this
+
+	localctx = NewQuantifierContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, FaultParserRULE_quantifier)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(437)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == FaultParserALL || _la == FaultParserANY) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
 // ITemporalContext is an interface to support dynamic dispatch.
 type ITemporalContext interface {
 	antlr.ParserRuleContext
@@ -7422,12 +7602,37 @@ func (s *TemporalContext) EVENTUALLYALWAYS() antlr.TerminalNode {
 	return s.GetToken(FaultParserEVENTUALLYALWAYS, 0)
 }
 
-func (s *TemporalContext) Integer() IIntegerContext {
+func (s *TemporalContext) AllInteger() []IIntegerContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IIntegerContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IIntegerContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IIntegerContext); ok {
+			tst[i] = t.(IIntegerContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TemporalContext) Integer(i int) IIntegerContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIntegerContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -7446,6 +7651,30 @@ func (s *TemporalContext) NFT() antlr.TerminalNode {
 	return s.GetToken(FaultParserNFT, 0)
 }
 
+func (s *TemporalContext) IDENT() antlr.TerminalNode {
+	return s.GetToken(FaultParserIDENT, 0)
+}
+
+func (s *TemporalContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(FaultParserCOMMA, 0)
+}
+
+func (s *TemporalContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
 func (s *TemporalContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -7481,7 +7710,7 @@ func (p *FaultParser) Temporal() (localctx ITemporalContext) {
 	_ = this
 
 	localctx = NewTemporalContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, FaultParserRULE_temporal)
+	p.EnterRule(localctx, 70, FaultParserRULE_temporal)
 	var _la int
 
 	defer func() {
@@ -7500,17 +7729,16 @@ func (p *FaultParser) Temporal() (localctx ITemporalContext) {
 		}
 	}()
 
-	p.SetState(432)
+	p.SetState(452)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case FaultParserEVENTUALLY, FaultParserEVENTUALLYALWAYS, FaultParserALWAYS:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 41, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(429)
+			p.SetState(439)
 			_la = p.GetTokenStream().LA(1)
 
-			if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&29360128) != 0) {
+			if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&58720256) != 0) {
 				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
@@ -7518,10 +7746,10 @@ func (p *FaultParser) Temporal() (localctx ITemporalContext) {
 			}
 		}
 
-	case FaultParserNMT, FaultParserNFT:
+	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(430)
+			p.SetState(440)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == FaultParserNMT || _la == FaultParserNFT) {
@@ -7532,12 +7760,54 @@ func (p *FaultParser) Temporal() (localctx ITemporalContext) {
 			}
 		}
 		{
-			p.SetState(431)
+			p.SetState(441)
 			p.Integer()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(442)
+			p.Match(FaultParserIDENT)
+		}
+		p.SetState(448)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64((_la-82)) & ^0x3f) == 0 && ((int64(1)<<(_la-82))&7) != 0 {
+			{
+				p.SetState(443)
+				p.Integer()
+			}
+			p.SetState(446)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == FaultParserCOMMA {
+				{
+					p.SetState(444)
+					p.Match(FaultParserCOMMA)
+				}
+				{
+					p.SetState(445)
+					p.Integer()
+				}
+
+			}
+
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(450)
+			p.Match(FaultParserIDENT)
+		}
+		{
+			p.SetState(451)
+			p.expression(0)
+		}
+
 	}
 
 	return localctx
@@ -7743,7 +8013,7 @@ func (p *FaultParser) Invariant() (localctx IInvariantContext) {
 	_ = this
 
 	localctx = NewInvariantContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, FaultParserRULE_invariant)
+	p.EnterRule(localctx, 72, FaultParserRULE_invariant)
 
 	defer func() {
 		p.ExitRule()
@@ -7761,14 +8031,14 @@ func (p *FaultParser) Invariant() (localctx IInvariantContext) {
 		}
 	}()
 
-	p.SetState(440)
+	p.SetState(460)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 38, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewInvarContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(434)
+			p.SetState(454)
 			p.expression(0)
 		}
 
@@ -7776,19 +8046,19 @@ func (p *FaultParser) Invariant() (localctx IInvariantContext) {
 		localctx = NewStageInvariantContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(435)
+			p.SetState(455)
 			p.Match(FaultParserWHEN)
 		}
 		{
-			p.SetState(436)
+			p.SetState(456)
 			p.expression(0)
 		}
 		{
-			p.SetState(437)
+			p.SetState(457)
 			p.Match(FaultParserTHEN)
 		}
 		{
-			p.SetState(438)
+			p.SetState(458)
 			p.expression(0)
 		}
 
@@ -8066,7 +8336,7 @@ func (p *FaultParser) Assignment() (localctx IAssignmentContext) {
 	_ = this
 
 	localctx = NewAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, FaultParserRULE_assignment)
+	p.EnterRule(localctx, 74, FaultParserRULE_assignment)
 	var _la int
 
 	defer func() {
@@ -8085,26 +8355,26 @@ func (p *FaultParser) Assignment() (localctx IAssignmentContext) {
 		}
 	}()
 
-	p.SetState(453)
+	p.SetState(473)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 40, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 44, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewMiscAssignContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(442)
+			p.SetState(462)
 			p.ExpressionList()
 		}
-		p.SetState(444)
+		p.SetState(464)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (int64((_la-60)) & ^0x3f) == 0 && ((int64(1)<<(_la-60))&2078721) != 0 {
+		if (int64((_la-61)) & ^0x3f) == 0 && ((int64(1)<<(_la-61))&2078721) != 0 {
 			{
-				p.SetState(443)
+				p.SetState(463)
 				_la = p.GetTokenStream().LA(1)
 
-				if !((int64((_la-60)) & ^0x3f) == 0 && ((int64(1)<<(_la-60))&2078721) != 0) {
+				if !((int64((_la-61)) & ^0x3f) == 0 && ((int64(1)<<(_la-61))&2078721) != 0) {
 					p.GetErrorHandler().RecoverInline(p)
 				} else {
 					p.GetErrorHandler().ReportMatch(p)
@@ -8114,11 +8384,11 @@ func (p *FaultParser) Assignment() (localctx IAssignmentContext) {
 
 		}
 		{
-			p.SetState(446)
+			p.SetState(466)
 			p.Match(FaultParserASSIGN)
 		}
 		{
-			p.SetState(447)
+			p.SetState(467)
 			p.ExpressionList()
 		}
 
@@ -8126,11 +8396,11 @@ func (p *FaultParser) Assignment() (localctx IAssignmentContext) {
 		localctx = NewFaultAssignContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(449)
+			p.SetState(469)
 			p.ExpressionList()
 		}
 		{
-			p.SetState(450)
+			p.SetState(470)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == FaultParserASSIGN_FLOW1 || _la == FaultParserASSIGN_FLOW2) {
@@ -8141,7 +8411,7 @@ func (p *FaultParser) Assignment() (localctx IAssignmentContext) {
 			}
 		}
 		{
-			p.SetState(451)
+			p.SetState(471)
 			p.ExpressionList()
 		}
 
@@ -8227,7 +8497,7 @@ func (p *FaultParser) EmptyStmt() (localctx IEmptyStmtContext) {
 	_ = this
 
 	localctx = NewEmptyStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, FaultParserRULE_emptyStmt)
+	p.EnterRule(localctx, 76, FaultParserRULE_emptyStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -8247,7 +8517,7 @@ func (p *FaultParser) EmptyStmt() (localctx IEmptyStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(455)
+		p.SetState(475)
 		p.Match(FaultParserSEMI)
 	}
 
@@ -8428,7 +8698,7 @@ func (p *FaultParser) IfStmt() (localctx IIfStmtContext) {
 	_ = this
 
 	localctx = NewIfStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, FaultParserRULE_ifStmt)
+	p.EnterRule(localctx, 78, FaultParserRULE_ifStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -8448,52 +8718,52 @@ func (p *FaultParser) IfStmt() (localctx IIfStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(457)
+		p.SetState(477)
 		p.Match(FaultParserIF)
 	}
-	p.SetState(461)
+	p.SetState(481)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 41, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(458)
+			p.SetState(478)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(459)
+			p.SetState(479)
 			p.Match(FaultParserSEMI)
 		}
 
 	}
 	{
-		p.SetState(463)
+		p.SetState(483)
 		p.expression(0)
 	}
 	{
-		p.SetState(464)
+		p.SetState(484)
 		p.Block()
 	}
-	p.SetState(470)
+	p.SetState(490)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(465)
+			p.SetState(485)
 			p.Match(FaultParserELSE)
 		}
-		p.SetState(468)
+		p.SetState(488)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case FaultParserIF:
 			{
-				p.SetState(466)
+				p.SetState(486)
 				p.IfStmt()
 			}
 
 		case FaultParserLCURLY:
 			{
-				p.SetState(467)
+				p.SetState(487)
 				p.Block()
 			}
 
@@ -8680,7 +8950,7 @@ func (p *FaultParser) IfStmtRun() (localctx IIfStmtRunContext) {
 	_ = this
 
 	localctx = NewIfStmtRunContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, FaultParserRULE_ifStmtRun)
+	p.EnterRule(localctx, 80, FaultParserRULE_ifStmtRun)
 
 	defer func() {
 		p.ExitRule()
@@ -8700,52 +8970,52 @@ func (p *FaultParser) IfStmtRun() (localctx IIfStmtRunContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(472)
+		p.SetState(492)
 		p.Match(FaultParserIF)
 	}
-	p.SetState(476)
+	p.SetState(496)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 44, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(473)
+			p.SetState(493)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(474)
+			p.SetState(494)
 			p.Match(FaultParserSEMI)
 		}
 
 	}
 	{
-		p.SetState(478)
+		p.SetState(498)
 		p.expression(0)
 	}
 	{
-		p.SetState(479)
+		p.SetState(499)
 		p.RunBlock()
 	}
-	p.SetState(485)
+	p.SetState(505)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 50, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(480)
+			p.SetState(500)
 			p.Match(FaultParserELSE)
 		}
-		p.SetState(483)
+		p.SetState(503)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case FaultParserIF:
 			{
-				p.SetState(481)
+				p.SetState(501)
 				p.IfStmtRun()
 			}
 
 		case FaultParserLCURLY:
 			{
-				p.SetState(482)
+				p.SetState(502)
 				p.RunBlock()
 			}
 
@@ -8932,7 +9202,7 @@ func (p *FaultParser) IfStmtState() (localctx IIfStmtStateContext) {
 	_ = this
 
 	localctx = NewIfStmtStateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, FaultParserRULE_ifStmtState)
+	p.EnterRule(localctx, 82, FaultParserRULE_ifStmtState)
 	var _la int
 
 	defer func() {
@@ -8953,53 +9223,53 @@ func (p *FaultParser) IfStmtState() (localctx IIfStmtStateContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(487)
+		p.SetState(507)
 		p.Match(FaultParserIF)
 	}
-	p.SetState(491)
+	p.SetState(511)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(488)
+			p.SetState(508)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(489)
+			p.SetState(509)
 			p.Match(FaultParserSEMI)
 		}
 
 	}
 	{
-		p.SetState(493)
+		p.SetState(513)
 		p.expression(0)
 	}
 	{
-		p.SetState(494)
+		p.SetState(514)
 		p.StateBlock()
 	}
-	p.SetState(500)
+	p.SetState(520)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserELSE {
 		{
-			p.SetState(495)
+			p.SetState(515)
 			p.Match(FaultParserELSE)
 		}
-		p.SetState(498)
+		p.SetState(518)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case FaultParserIF:
 			{
-				p.SetState(496)
+				p.SetState(516)
 				p.IfStmtState()
 			}
 
 		case FaultParserLCURLY:
 			{
-				p.SetState(497)
+				p.SetState(517)
 				p.StateBlock()
 			}
 
@@ -9141,7 +9411,7 @@ func (p *FaultParser) ForStmt() (localctx IForStmtContext) {
 	_ = this
 
 	localctx = NewForStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, FaultParserRULE_forStmt)
+	p.EnterRule(localctx, 84, FaultParserRULE_forStmt)
 	var _la int
 
 	defer func() {
@@ -9162,28 +9432,28 @@ func (p *FaultParser) ForStmt() (localctx IForStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(502)
+		p.SetState(522)
 		p.Match(FaultParserFOR)
 	}
 	{
-		p.SetState(503)
+		p.SetState(523)
 		p.Rounds()
 	}
 	{
-		p.SetState(504)
+		p.SetState(524)
 		p.Match(FaultParserRUN)
 	}
 	{
-		p.SetState(505)
+		p.SetState(525)
 		p.RunBlock()
 	}
-	p.SetState(507)
+	p.SetState(527)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserSEMI {
 		{
-			p.SetState(506)
+			p.SetState(526)
 			p.Eos()
 		}
 
@@ -9281,7 +9551,7 @@ func (p *FaultParser) Rounds() (localctx IRoundsContext) {
 	_ = this
 
 	localctx = NewRoundsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, FaultParserRULE_rounds)
+	p.EnterRule(localctx, 86, FaultParserRULE_rounds)
 
 	defer func() {
 		p.ExitRule()
@@ -9301,7 +9571,7 @@ func (p *FaultParser) Rounds() (localctx IRoundsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(509)
+		p.SetState(529)
 		p.Integer()
 	}
 
@@ -9401,7 +9671,7 @@ func (p *FaultParser) ParamCall() (localctx IParamCallContext) {
 	_ = this
 
 	localctx = NewParamCallContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, FaultParserRULE_paramCall)
+	p.EnterRule(localctx, 88, FaultParserRULE_paramCall)
 	var _la int
 
 	defer func() {
@@ -9424,7 +9694,7 @@ func (p *FaultParser) ParamCall() (localctx IParamCallContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(511)
+		p.SetState(531)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == FaultParserTHIS || _la == FaultParserIDENT) {
@@ -9435,32 +9705,32 @@ func (p *FaultParser) ParamCall() (localctx IParamCallContext) {
 		}
 	}
 	{
-		p.SetState(512)
+		p.SetState(532)
 		p.Match(FaultParserDOT)
 	}
 	{
-		p.SetState(513)
+		p.SetState(533)
 		p.Match(FaultParserIDENT)
 	}
-	p.SetState(518)
+	p.SetState(538)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(514)
+				p.SetState(534)
 				p.Match(FaultParserDOT)
 			}
 			{
-				p.SetState(515)
+				p.SetState(535)
 				p.Match(FaultParserIDENT)
 			}
 
 		}
-		p.SetState(520)
+		p.SetState(540)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -9588,7 +9858,7 @@ func (p *FaultParser) StateBlock() (localctx IStateBlockContext) {
 	_ = this
 
 	localctx = NewStateBlockContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, FaultParserRULE_stateBlock)
+	p.EnterRule(localctx, 90, FaultParserRULE_stateBlock)
 	var _la int
 
 	defer func() {
@@ -9609,25 +9879,25 @@ func (p *FaultParser) StateBlock() (localctx IStateBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(521)
+		p.SetState(541)
 		p.Match(FaultParserLCURLY)
 	}
-	p.SetState(525)
+	p.SetState(545)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17661981362176) != 0 {
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&35323962724352) != 0 {
 		{
-			p.SetState(522)
+			p.SetState(542)
 			p.StateStep()
 		}
 
-		p.SetState(527)
+		p.SetState(547)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(528)
+		p.SetState(548)
 		p.Match(FaultParserRCURLY)
 	}
 
@@ -9922,7 +10192,7 @@ func (p *FaultParser) StateStep() (localctx IStateStepContext) {
 	_ = this
 
 	localctx = NewStateStepContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, FaultParserRULE_stateStep)
+	p.EnterRule(localctx, 92, FaultParserRULE_stateStep)
 	var _la int
 
 	defer func() {
@@ -9941,7 +10211,7 @@ func (p *FaultParser) StateStep() (localctx IStateStepContext) {
 		}
 	}()
 
-	p.SetState(544)
+	p.SetState(564)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
@@ -9949,29 +10219,29 @@ func (p *FaultParser) StateStep() (localctx IStateStepContext) {
 		localctx = NewStateStepExprContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(530)
+			p.SetState(550)
 			p.ParamCall()
 		}
-		p.SetState(535)
+		p.SetState(555)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == FaultParserPIPE {
 			{
-				p.SetState(531)
+				p.SetState(551)
 				p.Match(FaultParserPIPE)
 			}
 			{
-				p.SetState(532)
+				p.SetState(552)
 				p.ParamCall()
 			}
 
-			p.SetState(537)
+			p.SetState(557)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(538)
+			p.SetState(558)
 			p.Eos()
 		}
 
@@ -9979,11 +10249,11 @@ func (p *FaultParser) StateStep() (localctx IStateStepContext) {
 		localctx = NewStateChainContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(540)
+			p.SetState(560)
 			p.stateChange(0)
 		}
 		{
-			p.SetState(541)
+			p.SetState(561)
 			p.Eos()
 		}
 
@@ -9991,7 +10261,7 @@ func (p *FaultParser) StateStep() (localctx IStateStepContext) {
 		localctx = NewStateExprContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(543)
+			p.SetState(563)
 			p.IfStmtState()
 		}
 
@@ -10124,7 +10394,7 @@ func (p *FaultParser) RunBlock() (localctx IRunBlockContext) {
 	_ = this
 
 	localctx = NewRunBlockContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, FaultParserRULE_runBlock)
+	p.EnterRule(localctx, 94, FaultParserRULE_runBlock)
 
 	defer func() {
 		p.ExitRule()
@@ -10146,27 +10416,27 @@ func (p *FaultParser) RunBlock() (localctx IRunBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(546)
+		p.SetState(566)
 		p.Match(FaultParserLCURLY)
 	}
-	p.SetState(550)
+	p.SetState(570)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(547)
+				p.SetState(567)
 				p.RunStep()
 			}
 
 		}
-		p.SetState(552)
+		p.SetState(572)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
 	}
 	{
-		p.SetState(553)
+		p.SetState(573)
 		p.Match(FaultParserRCURLY)
 	}
 
@@ -10509,7 +10779,7 @@ func (p *FaultParser) RunStep() (localctx IRunStepContext) {
 	_ = this
 
 	localctx = NewRunStepContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, FaultParserRULE_runStep)
+	p.EnterRule(localctx, 96, FaultParserRULE_runStep)
 	var _la int
 
 	defer func() {
@@ -10528,36 +10798,36 @@ func (p *FaultParser) RunStep() (localctx IRunStepContext) {
 		}
 	}()
 
-	p.SetState(577)
+	p.SetState(597)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewRunStepExprContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(555)
+			p.SetState(575)
 			p.ParamCall()
 		}
-		p.SetState(560)
+		p.SetState(580)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == FaultParserPIPE {
 			{
-				p.SetState(556)
+				p.SetState(576)
 				p.Match(FaultParserPIPE)
 			}
 			{
-				p.SetState(557)
+				p.SetState(577)
 				p.ParamCall()
 			}
 
-			p.SetState(562)
+			p.SetState(582)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(563)
+			p.SetState(583)
 			p.Eos()
 		}
 
@@ -10565,35 +10835,35 @@ func (p *FaultParser) RunStep() (localctx IRunStepContext) {
 		localctx = NewRunInitContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(565)
+			p.SetState(585)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(566)
+			p.SetState(586)
 			p.Match(FaultParserASSIGN)
 		}
 		{
-			p.SetState(567)
+			p.SetState(587)
 			p.Match(FaultParserNEW)
 		}
-		p.SetState(570)
+		p.SetState(590)
 		p.GetErrorHandler().Sync(p)
-		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) {
+		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(568)
+				p.SetState(588)
 				p.ParamCall()
 			}
 
 		case 2:
 			{
-				p.SetState(569)
+				p.SetState(589)
 				p.Match(FaultParserIDENT)
 			}
 
 		}
 		{
-			p.SetState(572)
+			p.SetState(592)
 			p.Eos()
 		}
 
@@ -10601,11 +10871,11 @@ func (p *FaultParser) RunStep() (localctx IRunStepContext) {
 		localctx = NewRunExprContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(573)
+			p.SetState(593)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(574)
+			p.SetState(594)
 			p.Eos()
 		}
 
@@ -10613,7 +10883,7 @@ func (p *FaultParser) RunStep() (localctx IRunStepContext) {
 		localctx = NewRunExprContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(576)
+			p.SetState(596)
 			p.IfStmtRun()
 		}
 
@@ -10723,7 +10993,7 @@ func (p *FaultParser) FaultType() (localctx IFaultTypeContext) {
 	_ = this
 
 	localctx = NewFaultTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, FaultParserRULE_faultType)
+	p.EnterRule(localctx, 98, FaultParserRULE_faultType)
 	var _la int
 
 	defer func() {
@@ -10744,10 +11014,10 @@ func (p *FaultParser) FaultType() (localctx IFaultTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(579)
+		p.SetState(599)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17454747090944) != 0) {
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&34909494181888) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -10904,7 +11174,7 @@ func (p *FaultParser) Solvable() (localctx ISolvableContext) {
 	_ = this
 
 	localctx = NewSolvableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, FaultParserRULE_solvable)
+	p.EnterRule(localctx, 100, FaultParserRULE_solvable)
 	var _la int
 
 	defer func() {
@@ -10925,44 +11195,44 @@ func (p *FaultParser) Solvable() (localctx ISolvableContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(581)
+		p.SetState(601)
 		p.FaultType()
 	}
 	{
-		p.SetState(582)
+		p.SetState(602)
 		p.Match(FaultParserLPAREN)
 	}
-	p.SetState(584)
+	p.SetState(604)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2269392941367312) != 0 || (int64((_la-72)) & ^0x3f) == 0 && ((int64(1)<<(_la-72))&32257) != 0 {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4538785882734624) != 0 || (int64((_la-73)) & ^0x3f) == 0 && ((int64(1)<<(_la-73))&32257) != 0 {
 		{
-			p.SetState(583)
+			p.SetState(603)
 			p.Operand()
 		}
 
 	}
-	p.SetState(590)
+	p.SetState(610)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserCOMMA {
 		{
-			p.SetState(586)
+			p.SetState(606)
 			p.Match(FaultParserCOMMA)
 		}
 		{
-			p.SetState(587)
+			p.SetState(607)
 			p.Operand()
 		}
 
-		p.SetState(592)
+		p.SetState(612)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(593)
+		p.SetState(613)
 		p.Match(FaultParserRPAREN)
 	}
 
@@ -11357,8 +11627,8 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IExpressionContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 100
-	p.EnterRecursionRule(localctx, 100, FaultParserRULE_expression, _p)
+	_startState := 102
+	p.EnterRecursionRule(localctx, 102, FaultParserRULE_expression, _p)
 	var _la int
 
 	defer func() {
@@ -11380,16 +11650,16 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(599)
+	p.SetState(619)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewExprContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 
 		{
-			p.SetState(596)
+			p.SetState(616)
 			p.Operand()
 		}
 
@@ -11398,7 +11668,7 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(597)
+			p.SetState(617)
 			p.Solvable()
 		}
 
@@ -11407,15 +11677,15 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(598)
+			p.SetState(618)
 			p.Prefix()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(621)
+	p.SetState(641)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -11423,39 +11693,39 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(619)
+			p.SetState(639)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewLrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
-				p.SetState(601)
+				p.SetState(621)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(602)
+					p.SetState(622)
 					p.Match(FaultParserEXPO)
 				}
 				{
-					p.SetState(603)
+					p.SetState(623)
 					p.expression(7)
 				}
 
 			case 2:
 				localctx = NewLrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
-				p.SetState(604)
+				p.SetState(624)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(605)
+					p.SetState(625)
 					_la = p.GetTokenStream().LA(1)
 
-					if !((int64((_la-60)) & ^0x3f) == 0 && ((int64(1)<<(_la-60))&2064385) != 0) {
+					if !((int64((_la-61)) & ^0x3f) == 0 && ((int64(1)<<(_la-61))&2064385) != 0) {
 						p.GetErrorHandler().RecoverInline(p)
 					} else {
 						p.GetErrorHandler().ReportMatch(p)
@@ -11463,23 +11733,23 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(606)
+					p.SetState(626)
 					p.expression(6)
 				}
 
 			case 3:
 				localctx = NewLrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
-				p.SetState(607)
+				p.SetState(627)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 				}
 				{
-					p.SetState(608)
+					p.SetState(628)
 					_la = p.GetTokenStream().LA(1)
 
-					if !((int64((_la-71)) & ^0x3f) == 0 && ((int64(1)<<(_la-71))&7) != 0) {
+					if !((int64((_la-72)) & ^0x3f) == 0 && ((int64(1)<<(_la-72))&7) != 0) {
 						p.GetErrorHandler().RecoverInline(p)
 					} else {
 						p.GetErrorHandler().ReportMatch(p)
@@ -11487,23 +11757,23 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(609)
+					p.SetState(629)
 					p.expression(5)
 				}
 
 			case 4:
 				localctx = NewLrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
-				p.SetState(610)
+				p.SetState(630)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 				}
 				{
-					p.SetState(611)
+					p.SetState(631)
 					_la = p.GetTokenStream().LA(1)
 
-					if !((int64((_la-63)) & ^0x3f) == 0 && ((int64(1)<<(_la-63))&63) != 0) {
+					if !((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&63) != 0) {
 						p.GetErrorHandler().RecoverInline(p)
 					} else {
 						p.GetErrorHandler().ReportMatch(p)
@@ -11511,50 +11781,50 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(612)
+					p.SetState(632)
 					p.expression(4)
 				}
 
 			case 5:
 				localctx = NewLrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
-				p.SetState(613)
+				p.SetState(633)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(614)
+					p.SetState(634)
 					p.Match(FaultParserAND)
 				}
 				{
-					p.SetState(615)
+					p.SetState(635)
 					p.expression(3)
 				}
 
 			case 6:
 				localctx = NewLrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
-				p.SetState(616)
+				p.SetState(636)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				}
 				{
-					p.SetState(617)
+					p.SetState(637)
 					p.Match(FaultParserOR)
 				}
 				{
-					p.SetState(618)
+					p.SetState(638)
 					p.expression(2)
 				}
 
 			}
 
 		}
-		p.SetState(623)
+		p.SetState(643)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -11753,7 +12023,7 @@ func (p *FaultParser) Operand() (localctx IOperandContext) {
 	_ = this
 
 	localctx = NewOperandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, FaultParserRULE_operand)
+	p.EnterRule(localctx, 104, FaultParserRULE_operand)
 
 	defer func() {
 		p.ExitRule()
@@ -11771,63 +12041,63 @@ func (p *FaultParser) Operand() (localctx IOperandContext) {
 		}
 	}()
 
-	p.SetState(634)
+	p.SetState(654)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 64, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(624)
+			p.SetState(644)
 			p.Nil_()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(625)
+			p.SetState(645)
 			p.Numeric()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(626)
+			p.SetState(646)
 			p.String_()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(627)
+			p.SetState(647)
 			p.Bool_()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(628)
+			p.SetState(648)
 			p.OperandName()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(629)
+			p.SetState(649)
 			p.AccessHistory()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(630)
+			p.SetState(650)
 			p.Match(FaultParserLPAREN)
 		}
 		{
-			p.SetState(631)
+			p.SetState(651)
 			p.expression(0)
 		}
 		{
-			p.SetState(632)
+			p.SetState(652)
 			p.Match(FaultParserRPAREN)
 		}
 
@@ -12135,7 +12405,7 @@ func (p *FaultParser) OperandName() (localctx IOperandNameContext) {
 	_ = this
 
 	localctx = NewOperandNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, FaultParserRULE_operandName)
+	p.EnterRule(localctx, 106, FaultParserRULE_operandName)
 
 	defer func() {
 		p.ExitRule()
@@ -12153,14 +12423,14 @@ func (p *FaultParser) OperandName() (localctx IOperandNameContext) {
 		}
 	}()
 
-	p.SetState(646)
+	p.SetState(666)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewOpNameContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(636)
+			p.SetState(656)
 			p.Match(FaultParserIDENT)
 		}
 
@@ -12168,7 +12438,7 @@ func (p *FaultParser) OperandName() (localctx IOperandNameContext) {
 		localctx = NewOpParamContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(637)
+			p.SetState(657)
 			p.ParamCall()
 		}
 
@@ -12176,7 +12446,7 @@ func (p *FaultParser) OperandName() (localctx IOperandNameContext) {
 		localctx = NewOpThisContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(638)
+			p.SetState(658)
 			p.Match(FaultParserTHIS)
 		}
 
@@ -12184,7 +12454,7 @@ func (p *FaultParser) OperandName() (localctx IOperandNameContext) {
 		localctx = NewOpClockContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(639)
+			p.SetState(659)
 			p.Match(FaultParserCLOCK)
 		}
 
@@ -12192,23 +12462,23 @@ func (p *FaultParser) OperandName() (localctx IOperandNameContext) {
 		localctx = NewOpInstanceContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(640)
+			p.SetState(660)
 			p.Match(FaultParserNEW)
 		}
 		{
-			p.SetState(641)
+			p.SetState(661)
 			p.Match(FaultParserIDENT)
 		}
-		p.SetState(644)
+		p.SetState(664)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 69, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(642)
+				p.SetState(662)
 				p.Match(FaultParserDOT)
 			}
 			{
-				p.SetState(643)
+				p.SetState(663)
 				p.Match(FaultParserIDENT)
 			}
 
@@ -12332,7 +12602,7 @@ func (p *FaultParser) Prefix() (localctx IPrefixContext) {
 	_ = this
 
 	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, FaultParserRULE_prefix)
+	p.EnterRule(localctx, 108, FaultParserRULE_prefix)
 	var _la int
 
 	defer func() {
@@ -12351,19 +12621,19 @@ func (p *FaultParser) Prefix() (localctx IPrefixContext) {
 		}
 	}()
 
-	p.SetState(651)
+	p.SetState(671)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 71, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(649)
+			p.SetState(669)
 			_la = p.GetTokenStream().LA(1)
 
-			if !((int64((_la-60)) & ^0x3f) == 0 && ((int64(1)<<(_la-60))&47109) != 0) {
+			if !((int64((_la-61)) & ^0x3f) == 0 && ((int64(1)<<(_la-61))&47109) != 0) {
 				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
@@ -12371,7 +12641,7 @@ func (p *FaultParser) Prefix() (localctx IPrefixContext) {
 			}
 		}
 		{
-			p.SetState(650)
+			p.SetState(670)
 			p.expression(0)
 		}
 
@@ -12501,7 +12771,7 @@ func (p *FaultParser) Numeric() (localctx INumericContext) {
 	_ = this
 
 	localctx = NewNumericContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, FaultParserRULE_numeric)
+	p.EnterRule(localctx, 110, FaultParserRULE_numeric)
 
 	defer func() {
 		p.ExitRule()
@@ -12519,28 +12789,28 @@ func (p *FaultParser) Numeric() (localctx INumericContext) {
 		}
 	}()
 
-	p.SetState(656)
+	p.SetState(676)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserDECIMAL_LIT, FaultParserOCTAL_LIT, FaultParserHEX_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(653)
+			p.SetState(673)
 			p.Integer()
 		}
 
 	case FaultParserMINUS:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(654)
+			p.SetState(674)
 			p.Negative()
 		}
 
 	case FaultParserFLOAT_LIT:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(655)
+			p.SetState(675)
 			p.Float_()
 		}
 
@@ -12636,7 +12906,7 @@ func (p *FaultParser) Integer() (localctx IIntegerContext) {
 	_ = this
 
 	localctx = NewIntegerContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, FaultParserRULE_integer)
+	p.EnterRule(localctx, 112, FaultParserRULE_integer)
 	var _la int
 
 	defer func() {
@@ -12657,10 +12927,10 @@ func (p *FaultParser) Integer() (localctx IIntegerContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(658)
+		p.SetState(678)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-81)) & ^0x3f) == 0 && ((int64(1)<<(_la-81))&7) != 0) {
+		if !((int64((_la-82)) & ^0x3f) == 0 && ((int64(1)<<(_la-82))&7) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -12780,7 +13050,7 @@ func (p *FaultParser) Negative() (localctx INegativeContext) {
 	_ = this
 
 	localctx = NewNegativeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, FaultParserRULE_negative)
+	p.EnterRule(localctx, 114, FaultParserRULE_negative)
 
 	defer func() {
 		p.ExitRule()
@@ -12798,28 +13068,28 @@ func (p *FaultParser) Negative() (localctx INegativeContext) {
 		}
 	}()
 
-	p.SetState(664)
+	p.SetState(684)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 69, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(660)
+			p.SetState(680)
 			p.Match(FaultParserMINUS)
 		}
 		{
-			p.SetState(661)
+			p.SetState(681)
 			p.Integer()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(662)
+			p.SetState(682)
 			p.Match(FaultParserMINUS)
 		}
 		{
-			p.SetState(663)
+			p.SetState(683)
 			p.Float_()
 		}
 
@@ -12905,7 +13175,7 @@ func (p *FaultParser) Float_() (localctx IFloat_Context) {
 	_ = this
 
 	localctx = NewFloat_Context(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, FaultParserRULE_float_)
+	p.EnterRule(localctx, 116, FaultParserRULE_float_)
 
 	defer func() {
 		p.ExitRule()
@@ -12925,7 +13195,7 @@ func (p *FaultParser) Float_() (localctx IFloat_Context) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(666)
+		p.SetState(686)
 		p.Match(FaultParserFLOAT_LIT)
 	}
 
@@ -13013,7 +13283,7 @@ func (p *FaultParser) String_() (localctx IString_Context) {
 	_ = this
 
 	localctx = NewString_Context(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, FaultParserRULE_string_)
+	p.EnterRule(localctx, 118, FaultParserRULE_string_)
 	var _la int
 
 	defer func() {
@@ -13034,7 +13304,7 @@ func (p *FaultParser) String_() (localctx IString_Context) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(668)
+		p.SetState(688)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == FaultParserRAW_STRING_LIT || _la == FaultParserINTERPRETED_STRING_LIT) {
@@ -13129,7 +13399,7 @@ func (p *FaultParser) Bool_() (localctx IBool_Context) {
 	_ = this
 
 	localctx = NewBool_Context(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, FaultParserRULE_bool_)
+	p.EnterRule(localctx, 120, FaultParserRULE_bool_)
 	var _la int
 
 	defer func() {
@@ -13150,7 +13420,7 @@ func (p *FaultParser) Bool_() (localctx IBool_Context) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(670)
+		p.SetState(690)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == FaultParserTRUE || _la == FaultParserFALSE) {
@@ -13257,7 +13527,7 @@ func (p *FaultParser) FunctionLit() (localctx IFunctionLitContext) {
 	_ = this
 
 	localctx = NewFunctionLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, FaultParserRULE_functionLit)
+	p.EnterRule(localctx, 122, FaultParserRULE_functionLit)
 
 	defer func() {
 		p.ExitRule()
@@ -13277,11 +13547,11 @@ func (p *FaultParser) FunctionLit() (localctx IFunctionLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(672)
+		p.SetState(692)
 		p.Match(FaultParserFUNC)
 	}
 	{
-		p.SetState(673)
+		p.SetState(693)
 		p.Block()
 	}
 
@@ -13381,7 +13651,7 @@ func (p *FaultParser) StateLit() (localctx IStateLitContext) {
 	_ = this
 
 	localctx = NewStateLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, FaultParserRULE_stateLit)
+	p.EnterRule(localctx, 124, FaultParserRULE_stateLit)
 
 	defer func() {
 		p.ExitRule()
@@ -13401,11 +13671,11 @@ func (p *FaultParser) StateLit() (localctx IStateLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(675)
+		p.SetState(695)
 		p.Match(FaultParserFUNC)
 	}
 	{
-		p.SetState(676)
+		p.SetState(696)
 		p.StateBlock()
 	}
 
@@ -13489,7 +13759,7 @@ func (p *FaultParser) Eos() (localctx IEosContext) {
 	_ = this
 
 	localctx = NewEosContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, FaultParserRULE_eos)
+	p.EnterRule(localctx, 126, FaultParserRULE_eos)
 
 	defer func() {
 		p.ExitRule()
@@ -13509,7 +13779,7 @@ func (p *FaultParser) Eos() (localctx IEosContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(678)
+		p.SetState(698)
 		p.Match(FaultParserSEMI)
 	}
 
@@ -13525,7 +13795,7 @@ func (p *FaultParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex i
 		}
 		return p.StateChange_Sempred(t, predIndex)
 
-	case 50:
+	case 51:
 		var t *ExpressionContext = nil
 		if localctx != nil {
 			t = localctx.(*ExpressionContext)
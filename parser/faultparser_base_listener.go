@@ -273,6 +273,12 @@ func (s *BaseFaultParserListener) EnterAssumption(ctx *AssumptionContext) {}
 // ExitAssumption is called when production assumption is exited.
 func (s *BaseFaultParserListener) ExitAssumption(ctx *AssumptionContext) {}
 
+// EnterQuantifier is called when production quantifier is entered.
+func (s *BaseFaultParserListener) EnterQuantifier(ctx *QuantifierContext) {}
+
+// ExitQuantifier is called when production quantifier is exited.
+func (s *BaseFaultParserListener) ExitQuantifier(ctx *QuantifierContext) {}
+
 // EnterTemporal is called when production temporal is entered.
 func (s *BaseFaultParserListener) EnterTemporal(ctx *TemporalContext) {}
 
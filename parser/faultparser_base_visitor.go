@@ -176,6 +176,10 @@ func (v *BaseFaultParserVisitor) VisitAssumption(ctx *AssumptionContext) interfa
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseFaultParserVisitor) VisitQuantifier(ctx *QuantifierContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseFaultParserVisitor) VisitTemporal(ctx *TemporalContext) interface{} {
 	return v.VisitChildren(ctx)
 }
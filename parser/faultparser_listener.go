@@ -134,6 +134,9 @@ type FaultParserListener interface {
 	// EnterAssumption is called when entering the assumption production.
 	EnterAssumption(c *AssumptionContext)
 
+	// EnterQuantifier is called when entering the quantifier production.
+	EnterQuantifier(c *QuantifierContext)
+
 	// EnterTemporal is called when entering the temporal production.
 	EnterTemporal(c *TemporalContext)
 
@@ -386,6 +389,9 @@ type FaultParserListener interface {
 	// ExitAssumption is called when exiting the assumption production.
 	ExitAssumption(c *AssumptionContext)
 
+	// ExitQuantifier is called when exiting the quantifier production.
+	ExitQuantifier(c *QuantifierContext)
+
 	// ExitTemporal is called when exiting the temporal production.
 	ExitTemporal(c *TemporalContext)
 
@@ -134,6 +134,9 @@ type FaultParserVisitor interface {
 	// Visit a parse tree produced by FaultParser#assumption.
 	VisitAssumption(ctx *AssumptionContext) interface{}
 
+	// Visit a parse tree produced by FaultParser#quantifier.
+	VisitQuantifier(ctx *QuantifierContext) interface{}
+
 	// Visit a parse tree produced by FaultParser#temporal.
 	VisitTemporal(ctx *TemporalContext) interface{}
 
@@ -414,6 +414,14 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 			return node, err
 		}
 		node.Constraint = pro.(*ast.InvariantClause)
+
+		if node.Until != nil {
+			pro, err = p.walk(node.Until)
+			if err != nil {
+				return node, err
+			}
+			node.Until = pro.(*ast.InvariantClause)
+		}
 		return node, err
 	case *ast.InvariantClause:
 		l, err := p.walk(node.Left)
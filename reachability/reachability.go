@@ -3,28 +3,92 @@ package reachability
 import (
 	"fault/ast"
 	"fmt"
-	"os"
+	"sort"
 	"strings"
 )
 
 type Tracer struct {
-	graph     map[string]bool
-	undefined []string
-	last      string
+	graph      map[string]bool
+	undefined  []string
+	last       string           // component being walked
+	positions  map[string][]int // where states and components are defined
+	refs       map[string][]int // where undefined states are named
+	components []string
+	advances   map[string]bool // components with at least one advance call
 }
 
-func NewTracer() *Tracer {
-	return &Tracer{graph: make(map[string]bool)}
+// What's wrong with a system's state chart, each
+// problem with where it is in the spec.
+type Result struct {
+	Unreachable   []*Issue `json:"unreachable,omitempty"`    // states nothing advances to
+	Undefined     []*Issue `json:"undefined,omitempty"`      // states in start or advance that don't exist
+	NoTransitions []*Issue `json:"no_transitions,omitempty"` // components that never advance
+}
+
+type Issue struct {
+	Name     string `json:"name"` // component_state, or the component
+	Position []int  `json:"position,omitempty"`
+}
+
+func (i *Issue) Line() int {
+	if len(i.Position) == 0 {
+		return 0
+	}
+	return i.Position[0]
+}
+
+// Errors mean the system is under specified,
+// a component without transitions may be on purpose
+func (r *Result) Errors() bool {
+	return len(r.Unreachable) > 0 || len(r.Undefined) > 0
 }
 
+func NewTracer() *Tracer {
+	return &Tracer{
+		graph:     make(map[string]bool),
+		positions: make(map[string][]int),
+		refs:      make(map[string][]int),
+		advances:  make(map[string]bool),
+	}
+}
 
-func (t *Tracer) Scan(spec *ast.Spec) {
+func (t *Tracer) Scan(spec *ast.Spec) *Result {
 	t.walk(spec)
-	ch, missing := t.check()
-	if !ch {
-		fmt.Fprintf(os.Stderr, "error: system under specified, states %s are unreachable\n", missing)
-		os.Exit(1)
+	_, missing := t.check()
+
+	r := &Result{}
+	seen := make(map[string]bool)
+	for _, id := range missing {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if pos, ok := t.positions[id]; ok { // Defined, but nothing gets to it
+			r.Unreachable = append(r.Unreachable, &Issue{Name: id, Position: pos})
+		} else {
+			r.Undefined = append(r.Undefined, &Issue{Name: id, Position: t.refs[id]})
+		}
+	}
+
+	for _, c := range t.components {
+		if !t.advances[c] {
+			r.NoTransitions = append(r.NoTransitions, &Issue{Name: c, Position: t.positions[c]})
+		}
 	}
+
+	sortIssues(r.Unreachable)
+	sortIssues(r.Undefined)
+	return r
+}
+
+func sortIssues(issues []*Issue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Line() != issues[j].Line() {
+			return issues[i].Line() < issues[j].Line()
+		}
+		return issues[i].Name < issues[j].Name
+	})
 }
 
 func (t *Tracer) walk(n ast.Node) {
@@ -37,6 +101,9 @@ func (t *Tracer) walk(n ast.Node) {
 		t.walk(node.Value)
 	case *ast.ComponentLiteral:
 		nid := node.Id()
+		t.last = nid[1]
+		t.components = append(t.components, nid[1])
+		t.positions[nid[1]] = node.Position()
 		for k, v := range node.Pairs {
 			if f, ok := v.(*ast.FunctionLiteral); ok {
 				pid := k.Id()
@@ -44,6 +111,7 @@ func (t *Tracer) walk(n ast.Node) {
 				if _, ok := t.graph[id]; !ok {
 					t.graph[id] = false
 				}
+				t.positions[id] = k.Position()
 
 				if t.seenBefore(id) {
 					t.graph[id] = true
@@ -53,11 +121,13 @@ func (t *Tracer) walk(n ast.Node) {
 				t.walk(f)
 			}
 		}
+		t.last = ""
 	case *ast.StartStatement:
 		for _, v := range node.Pairs {
 			id := strings.Join(v, "_")
 			if _, ok := t.graph[id]; !ok {
 				t.undefined = append(t.undefined, id)
+				t.named(id, node.Position())
 			} else {
 				t.graph[id] = true
 				t.removeUndefined(id)
@@ -81,10 +151,15 @@ func (t *Tracer) walk(n ast.Node) {
 			t.walk(node.Alternative)
 		}
 	case *ast.BuiltIn:
+		if node.Function == "advance" && t.last != "" {
+			t.advances[t.last] = true
+		}
+
 		for _, v := range node.Parameters {
 			id := v.(ast.Nameable).Id()
 			if _, ok := t.graph[id[1]]; !ok {
 				t.undefined = append(t.undefined, id[1])
+				t.named(id[1], node.Position())
 			} else {
 				t.graph[id[1]] = true
 				t.removeUndefined(id[1])
@@ -93,6 +168,12 @@ func (t *Tracer) walk(n ast.Node) {
 	}
 }
 
+func (t *Tracer) named(id string, pos []int) {
+	if _, ok := t.refs[id]; !ok {
+		t.refs[id] = pos
+	}
+}
+
 func (t *Tracer) seenBefore(id string) bool {
 	for _, v := range t.undefined {
 		if v == id {
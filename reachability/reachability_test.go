@@ -216,6 +216,56 @@ func TestMultiPath(t *testing.T) {
 	}
 }
 
+func TestScan(t *testing.T) {
+	test := `system test;
+
+	component foo = states{
+		initial: func{
+			advance(this.alarm);
+		},
+		alarm: func{
+			stay();
+		},
+		error: func{
+			stay();
+		},
+	};
+
+	component bar = states{
+		idle: func{
+			stay();
+		},
+	};
+
+	start {
+		foo: initial,
+		bar: idle,
+		fizz: buzz,
+	};
+	`
+	flags := map[string]bool{"specType": false, "testing": true, "skipRun": false}
+	l := listener.Execute(test, "", flags)
+	pre := preprocess.Execute(l)
+	ty := types.Execute(pre.Processed, pre.Specs)
+	r := NewTracer().Scan(ty.Checked)
+
+	if !r.Errors() {
+		t.Fatal("scan should report errors")
+	}
+
+	if len(r.Unreachable) != 1 || r.Unreachable[0].Name != "foo_error" || r.Unreachable[0].Line() != 10 {
+		t.Fatalf("unreachable state is incorrect. got=%+v", r.Unreachable)
+	}
+
+	if len(r.Undefined) != 1 || r.Undefined[0].Name != "fizz_buzz" || r.Undefined[0].Line() != 21 {
+		t.Fatalf("undefined start state is incorrect. got=%+v", r.Undefined)
+	}
+
+	if len(r.NoTransitions) != 1 || r.NoTransitions[0].Name != "bar" || r.NoTransitions[0].Line() != 15 {
+		t.Fatalf("component without transitions is incorrect. got=%+v", r.NoTransitions)
+	}
+}
+
 func prepTestSys(test string) (bool, []string) {
 	flags := make(map[string]bool)
 	flags["specType"] = false
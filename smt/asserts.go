@@ -6,11 +6,39 @@ import (
 	"fault/smt/rules"
 	"fault/util"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
 
+// SSARegex matches a variable at one of its states, test1_t_foo_value_2
+var SSARegex = regexp.MustCompile(`[A-Za-z][A-Za-z0-9_]*_[0-9]+`)
+
 func (g *Generator) parseAssert(a *ast.AssertionStatement) string {
+	switch a.Constraint.Operator {
+	case llvm.ONE_STATE:
+		return g.oneState(a)
+	case llvm.DEADLOCK:
+		return g.deadlock(a)
+	case llvm.LIVELOCK:
+		return g.livelock(a)
+	case llvm.ENTERED:
+		return g.entered(a)
+	case llvm.FIRES:
+		return g.fires(a)
+	}
+
+	switch a.Quantifier {
+	case "any":
+		return g.parseAny(a)
+	case "all", "":
+		// Every instance keeps to the assert, the
+		// default when the spec doesn't say
+	default:
+		panic(fmt.Sprintf("unknown quantifier %s", a.Quantifier))
+	}
+
 	stateRange := a.Constraint.Operator == "then"
 	if stateRange && (a.TemporalFilter != "" || a.Temporal != "") {
 		panic("cannot mix temporal logic with when/then assertions")
@@ -36,6 +64,16 @@ func (g *Generator) parseAssert(a *ast.AssertionStatement) string {
 		off = "or"
 	}
 
+	if roundFilter(a.TemporalFilter) {
+		var qs []string
+		if a.Until != nil {
+			ql := g.parseInvariantNode(a.Until.Left, false)
+			qr := g.parseInvariantNode(a.Until.Right, false)
+			qs = g.stateClauses(ql, qr, smtlibOperators(a.Until.Operator))
+		}
+		return g.roundTemporal(a, g.stateClauses(left, right, op), qs)
+	}
+
 	//If left and right are asserts on the same variable
 	dset := util.DiffStrSets(left.Bases, right.Bases)
 	if dset.Len() == 0 && (a.Temporal != "" || a.TemporalFilter != "") {
@@ -572,6 +610,394 @@ func expandAssertStateGraph(list1 []string, list2 []string, op string, temporalF
 	}
 }
 
+func (g *Generator) parseAny(a *ast.AssertionStatement) string {
+	// any holds if one instance keeps to the assert, so it's
+	// only violated when every instance breaks it. Each
+	// instance gets its own rule, then they're joined.
+	var rules []string
+	for _, root := range instanceRoots(a.Constraint) {
+		q := *a
+		q.Quantifier = "all"
+		q.Constraint = &ast.InvariantClause{
+			Token:    a.Constraint.Token,
+			Left:     onlyInstance(a.Constraint.Left, root),
+			Operator: a.Constraint.Operator,
+			Right:    onlyInstance(a.Constraint.Right, root),
+		}
+		rules = append(rules, g.parseAssert(&q))
+	}
+
+	if len(rules) == 0 { // Nothing to choose between
+		q := *a
+		q.Quantifier = "all"
+		return g.parseAssert(&q)
+	}
+
+	if a.Assume {
+		return joinClauses("or", rules)
+	}
+	return joinClauses("and", rules)
+}
+
+func (g *Generator) oneState(a *ast.AssertionStatement) string {
+	// Violated when, at the end of a round, a component
+	// has no state active or more than one
+	var clauses []string
+	for _, vars := range g.roundEnds(a.Constraint.Left.(*ast.AssertVar).Instances) {
+		if vars == nil {
+			continue
+		}
+
+		wrong := []string{anyState(vars, true)} // none active
+		for i := range vars {
+			for j := i + 1; j < len(vars); j++ {
+				wrong = append(wrong, fmt.Sprintf("(and %s %s)", vars[i], vars[j]))
+			}
+		}
+		clauses = append(clauses, joinClauses("or", wrong))
+	}
+	return joinClauses("or", clauses)
+}
+
+func (g *Generator) deadlock(a *ast.AssertionStatement) string {
+	// Violated in a round where every component kept
+	// the state it had or has no active state
+	var components [][][]string
+	for cl := a.Constraint; cl != nil; {
+		components = append(components, g.roundEnds(cl.Left.(*ast.AssertVar).Instances))
+		cl, _ = cl.Right.(*ast.InvariantClause)
+	}
+
+	rounds := len(components[0])
+	for _, ends := range components {
+		if len(ends) < rounds {
+			rounds = len(ends)
+		}
+	}
+
+	var clauses []string
+	for r := 1; r < rounds; r++ {
+		var stuck []string
+		for _, ends := range components {
+			if ends[r-1] == nil || ends[r] == nil {
+				stuck = nil
+				break
+			}
+
+			var same []string
+			for i, v := range ends[r] {
+				if v != ends[r-1][i] {
+					same = append(same, fmt.Sprintf("(= %s %s)", ends[r-1][i], v))
+				}
+			}
+
+			if len(same) == 0 { // Nothing in the component can change
+				stuck = append(stuck, "true")
+				continue
+			}
+			stuck = append(stuck, fmt.Sprintf("(or %s %s)", joinClauses("and", same), anyState(ends[r], true)))
+		}
+
+		if len(stuck) > 0 {
+			clauses = append(clauses, joinClauses("and", stuck))
+		}
+	}
+	return joinClauses("or", clauses)
+}
+
+func (g *Generator) livelock(a *ast.AssertionStatement) string {
+	// Violated when the states at the end of round j are the
+	// same as at the end of an earlier round i and no progress
+	// state is active in the rounds between
+	ends := g.roundEnds(a.Constraint.Left.(*ast.AssertVar).Instances)
+	visits := g.roundEnds(a.Constraint.Right.(*ast.AssertVar).Instances)
+
+	var clauses []string
+	for i := range ends {
+		for j := i + 1; j < len(ends); j++ {
+			if ends[i] == nil || ends[j] == nil {
+				continue
+			}
+
+			var cycle []string
+			for n, v := range ends[j] {
+				if v != ends[i][n] {
+					cycle = append(cycle, fmt.Sprintf("(= %s %s)", ends[i][n], v))
+				}
+			}
+
+			for k := i + 1; k <= j; k++ {
+				if k >= len(visits) || visits[k] == nil {
+					continue
+				}
+				cycle = append(cycle, anyState(visits[k], true))
+			}
+
+			if len(cycle) > 0 {
+				clauses = append(clauses, joinClauses("and", cycle))
+			}
+		}
+	}
+	return joinClauses("or", clauses)
+}
+
+func (g *Generator) entered(a *ast.AssertionStatement) string {
+	// The state is active at the end of some round
+	var clauses []string
+	for _, vars := range g.roundEnds(a.Constraint.Left.(*ast.AssertVar).Instances) {
+		if vars != nil {
+			clauses = append(clauses, vars[0])
+		}
+	}
+	return joinClauses("or", clauses)
+}
+
+func (g *Generator) fires(a *ast.AssertionStatement) string {
+	// The from state is active at the end of one round,
+	// and the to state instead of it at the end of the next.
+	// Steps inside a round aren't compared, so a transition
+	// undone before the round ends doesn't count.
+	from := g.roundEnds(a.Constraint.Left.(*ast.AssertVar).Instances)
+	to := g.roundEnds(a.Constraint.Right.(*ast.AssertVar).Instances)
+
+	var clauses []string
+	for r := 1; r < len(from) && r < len(to); r++ {
+		if from[r-1] == nil || from[r] == nil || to[r] == nil {
+			continue
+		}
+		clauses = append(clauses, fmt.Sprintf("(and %s (not %s) %s)", from[r-1][0], from[r][0], to[r][0]))
+	}
+	return joinClauses("or", clauses)
+}
+
+func (g *Generator) roundEnds(states []string) [][]string {
+	// The variable holding each state at the end of every
+	// round, nil for rounds before all of them exist
+	last := -1
+	ends := make(map[string]map[int]int) // latest state of each variable by round
+	for _, base := range states {
+		ends[base] = make(map[int]int)
+		for _, s := range g.RVarLookup[base] {
+			if n, ok := ends[base][s[1]]; !ok || s[0] > n {
+				ends[base][s[1]] = s[0]
+			}
+			if s[1] > last {
+				last = s[1]
+			}
+		}
+	}
+
+	var rounds [][]string
+	current := make(map[string]string)
+	for r := 0; r <= last; r++ {
+		var vars []string
+		for _, base := range states {
+			if n, ok := ends[base][r]; ok {
+				current[base] = fmt.Sprintf("%s_%d", base, n)
+			}
+			if v, ok := current[base]; ok {
+				vars = append(vars, v)
+			}
+		}
+
+		if len(vars) < len(states) { // Not every state exists yet
+			vars = nil
+		}
+		rounds = append(rounds, vars)
+	}
+	return rounds
+}
+
+func instanceRoots(exp ast.Expression) []string {
+	// The instances of the first variable in the
+	// assert are the ones any chooses from
+	switch e := exp.(type) {
+	case *ast.InvariantClause:
+		if roots := instanceRoots(e.Left); len(roots) > 0 {
+			return roots
+		}
+		return instanceRoots(e.Right)
+	case *ast.InfixExpression:
+		if roots := instanceRoots(e.Left); len(roots) > 0 {
+			return roots
+		}
+		return instanceRoots(e.Right)
+	case *ast.PrefixExpression:
+		return instanceRoots(e.Right)
+	case *ast.AssertVar:
+		var roots []string
+		for _, v := range e.Instances {
+			roots = append(roots, instanceRoot(v))
+		}
+		sort.Strings(roots)
+		return roots
+	}
+	return nil
+}
+
+func instanceRoot(id string) string {
+	// test1_t_foo_value belongs to test1_t_foo
+	return id[:strings.LastIndex(id, "_")+1]
+}
+
+func onlyInstance(exp ast.Expression, root string) ast.Expression {
+	// Copies the expression, dropping the instances
+	// of every variable that don't belong to root
+	switch e := exp.(type) {
+	case *ast.InfixExpression:
+		c := *e
+		c.Left = onlyInstance(e.Left, root)
+		c.Right = onlyInstance(e.Right, root)
+		return &c
+	case *ast.PrefixExpression:
+		c := *e
+		c.Right = onlyInstance(e.Right, root)
+		return &c
+	case *ast.AssertVar:
+		var instances []string
+		for _, v := range e.Instances {
+			if strings.HasPrefix(v, root) {
+				instances = append(instances, v)
+			}
+		}
+		if len(instances) == 0 { // Not a variable of this instance
+			return e
+		}
+		c := *e
+		c.Instances = instances
+		return &c
+	}
+	return exp
+}
+
+func roundFilter(filter string) bool {
+	switch strings.TrimPrefix(filter, "not-") {
+	case "next", "within", "between", "until":
+		return true
+	}
+	return false
+}
+
+func (g *Generator) stateClauses(left *rules.StateGroup, right *rules.StateGroup, op string) []string {
+	// One clause per state, the same way the
+	// other temporal filters see them
+	if util.DiffStrSets(left.Bases, right.Bases).Len() == 0 {
+		return g.flattenStates(g.mergeInvariantInfix(left, right, op))
+	}
+	return expandAssertStateGraph(g.flattenStates(left), g.flattenStates(right), op, "", 0)
+}
+
+func (g *Generator) roundTemporal(a *ast.AssertionStatement, ps []string, qs []string) string {
+	// next, within, between and until count rounds of the
+	// run block as the spec does, from 1. The setup before
+	// the run block (round 0) is only ever in a within or
+	// until window. Asserts come in negated (not-within,
+	// etc) so the rule is true on a violation.
+	filter := strings.TrimPrefix(a.TemporalFilter, "not-")
+	negated := filter != a.TemporalFilter
+	pos := a.Position()
+
+	last := 0
+	rounds := make(map[int][]string)
+	for _, c := range ps {
+		r := g.specRound(g.clauseRound(c))
+		rounds[r] = append(rounds[r], c)
+		if r > last {
+			last = r
+		}
+	}
+
+	var window []string
+	switch filter {
+	case "next": // holds all through the second round
+		window = rounds[2]
+		if len(window) == 0 {
+			panic(fmt.Sprintf("next needs at least two rounds in the run block: line %d col %d", pos[0], pos[1]))
+		}
+		return allStates(window, negated)
+	case "within": // holds at some point in the first N rounds
+		for r := 0; r <= a.TemporalN && r <= last; r++ {
+			window = append(window, rounds[r]...)
+		}
+		return anyState(window, negated)
+	case "between": // holds all through rounds a to b
+		if a.TemporalM > last {
+			panic(fmt.Sprintf("between %d, %d is past the last round of the run block (%d): line %d col %d", a.TemporalN, a.TemporalM, last, pos[0], pos[1]))
+		}
+		for r := a.TemporalN; r <= a.TemporalM; r++ {
+			window = append(window, rounds[r]...)
+		}
+		return allStates(window, negated)
+	}
+
+	// until, holds in every round before the one
+	// where the other side first becomes true
+	until := make(map[int][]string)
+	for _, c := range qs {
+		r := g.specRound(g.clauseRound(c))
+		until[r] = append(until[r], c)
+		if r > last {
+			last = r
+		}
+	}
+
+	var options, before []string
+	for r := 0; r <= last; r++ {
+		if len(until[r]) > 0 {
+			q := anyState(until[r], false)
+			if len(before) > 0 {
+				q = fmt.Sprintf("(and %s %s)", q, allStates(before, false))
+			}
+			options = append(options, q)
+		}
+		before = append(before, rounds[r]...)
+	}
+
+	if len(options) == 0 {
+		panic(fmt.Sprintf("until has nothing to wait for: line %d col %d", pos[0], pos[1]))
+	}
+
+	rule := anyState(options, false)
+	if negated {
+		return fmt.Sprintf("(not %s)", rule)
+	}
+	return rule
+}
+
+func allStates(clauses []string, negated bool) string {
+	// Every clause holds, or (negated) at least one doesn't
+	if negated {
+		return joinClauses("or", notClauses(clauses))
+	}
+	return joinClauses("and", clauses)
+}
+
+func anyState(clauses []string, negated bool) string {
+	// At least one clause holds, or (negated) none do
+	if negated {
+		return joinClauses("and", notClauses(clauses))
+	}
+	return joinClauses("or", clauses)
+}
+
+func notClauses(clauses []string) []string {
+	var n []string
+	for _, c := range clauses {
+		n = append(n, fmt.Sprintf("(not %s)", c))
+	}
+	return n
+}
+
+func joinClauses(op string, clauses []string) string {
+	switch len(clauses) {
+	case 0: // and of nothing holds, or of nothing doesn't
+		return fmt.Sprint(op == "and")
+	case 1:
+		return clauses[0]
+	}
+	return fmt.Sprintf("(%s %s)", op, strings.Join(clauses, " "))
+}
+
 func packageStateGraph(x [][]string, op string) []string {
 	var product []string
 	for _, a := range x {
@@ -642,3 +1068,33 @@ func captureState(id string) (string, bool, bool) {
 	}
 
 }
+
+func splitClauses(rule string) []string {
+	// A violation in any state is enough, so the top
+	// level of (or ...) can be checked one by one
+	parts := util.SexprList(rule)
+	if len(parts) < 2 || parts[0] != "or" {
+		return []string{strings.TrimSpace(rule)}
+	}
+	return parts[1:]
+}
+
+func (g *Generator) clauseRound(clause string) int {
+	// The latest round of any state in the clause,
+	// by then the violation has happened
+	var round int
+	for _, id := range SSARegex.FindAllString(clause, -1) {
+		i := strings.LastIndex(id, "_")
+		num, err := strconv.Atoi(id[i+1:])
+		if err != nil {
+			continue
+		}
+
+		for _, s := range g.RVarLookup[id[:i]] {
+			if s[0] == num && s[1] > round {
+				round = s[1]
+			}
+		}
+	}
+	return round
+}
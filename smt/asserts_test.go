@@ -1,6 +1,12 @@
 package smt
 
 import (
+	"fault/listener"
+	"fault/llvm"
+	"fault/preprocess"
+	"fault/types"
+	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -38,7 +44,7 @@ func TestSimpleAssert(t *testing.T) {
 	(assert (= test1_t_foo_value_3 (- test1_t_foo_value_2 2.0)))
 	(assert (= test1_t_foo_value_4 (- test1_t_foo_value_3 2.0)))
 	(assert (= test1_t_foo_value_5 (- test1_t_foo_value_4 2.0)))
-	(assert (or (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_1 0)(<= test1_t_foo_value_2 0)(<= test1_t_foo_value_3 0)(<= test1_t_foo_value_4 0)(<= test1_t_foo_value_5 0)))
+	(assert (! (or (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_1 0)(<= test1_t_foo_value_2 0)(<= test1_t_foo_value_3 0)(<= test1_t_foo_value_4 0)(<= test1_t_foo_value_5 0)) :named assert_0))
 `
 
 	smt := prepTest("", test, true, false)
@@ -84,7 +90,7 @@ func TestCompoundAssertAnd(t *testing.T) {
 	(assert (= test1_t_foo_value_3 (- test1_t_foo_value_2 2.0)))
 	(assert (= test1_t_foo_value_4 (- test1_t_foo_value_3 2.0)))
 	(assert (= test1_t_foo_value_5 (- test1_t_foo_value_4 2.0)))
-	(assert (or (<= test1_t_foo_value_0 0)
+	(assert (! (or (<= test1_t_foo_value_0 0)
 	(<= test1_t_foo_value_1 0)
 	(<= test1_t_foo_value_2 0)
 	(<= test1_t_foo_value_3 0)
@@ -95,7 +101,7 @@ func TestCompoundAssertAnd(t *testing.T) {
 	(> test1_t_foo_value_2 10)
 	(> test1_t_foo_value_3 10)
 	(> test1_t_foo_value_4 10)
-	(> test1_t_foo_value_5 10)))`
+	(> test1_t_foo_value_5 10)) :named assert_0))`
 
 	smt := prepTest("", test, true, false)
 
@@ -140,7 +146,7 @@ func TestCompoundAssertOr(t *testing.T) {
 	(assert (= test1_t_foo_value_3 (- test1_t_foo_value_2 2.0)))
 	(assert (= test1_t_foo_value_4 (- test1_t_foo_value_3 2.0)))
 	(assert (= test1_t_foo_value_5 (- test1_t_foo_value_4 2.0)))
-	(assert (or (and (<= test1_t_foo_value_5 0) (> test1_t_foo_value_5 10)) (and (<= test1_t_foo_value_0 0) (> test1_t_foo_value_0 10)) (and (<= test1_t_foo_value_1 0) (> test1_t_foo_value_1 10)) (and (<= test1_t_foo_value_2 0) (> test1_t_foo_value_2 10)) (and (<= test1_t_foo_value_3 0) (> test1_t_foo_value_3 10)) (and (<= test1_t_foo_value_4 0) (> test1_t_foo_value_4 10))))`
+	(assert (! (or (and (<= test1_t_foo_value_5 0) (> test1_t_foo_value_5 10)) (and (<= test1_t_foo_value_0 0) (> test1_t_foo_value_0 10)) (and (<= test1_t_foo_value_1 0) (> test1_t_foo_value_1 10)) (and (<= test1_t_foo_value_2 0) (> test1_t_foo_value_2 10)) (and (<= test1_t_foo_value_3 0) (> test1_t_foo_value_3 10)) (and (<= test1_t_foo_value_4 0) (> test1_t_foo_value_4 10))) :named assert_0))`
 
 	smt := prepTest("", test, true, false)
 
@@ -187,22 +193,22 @@ func TestMultiAssert(t *testing.T) {
 	(assert (= test1_t_foo_value_4 (- test1_t_foo_value_3 2.0)))
 	(assert (= test1_t_foo_value_5 (- test1_t_foo_value_4 2.0)))
 	(assert (or 
-		(or 
+		(! (or 
 			(<= test1_t_foo_value_0 0)
 			(<= test1_t_foo_value_1 0)
 			(<= test1_t_foo_value_2 0)
 			(<= test1_t_foo_value_3 0)
 			(<= test1_t_foo_value_4 0)
 			(<= test1_t_foo_value_5 0)
-		)
-		(or 
+		) :named assert_0)
+		(! (or 
 			(> test1_t_foo_value_0 10)
 			(> test1_t_foo_value_1 10)
 			(> test1_t_foo_value_2 10)
 			(> test1_t_foo_value_3 10)
 			(> test1_t_foo_value_4 10)
 			(> test1_t_foo_value_5 10)
-		)
+		) :named assert_1)
 	))`
 
 	smt := prepTest("", test, true, false)
@@ -248,12 +254,12 @@ func TestAssertInfix(t *testing.T) {
 	(assert (= test1_t_foo_value_3 (- test1_t_foo_value_2 2.0)))
 	(assert (= test1_t_foo_value_4 (- test1_t_foo_value_3 2.0)))
 	(assert (= test1_t_foo_value_5 (- test1_t_foo_value_4 2.0)))
-	(assert (or (<= test1_t_foo_value_0 0)
+	(assert (! (or (<= test1_t_foo_value_0 0)
 	(<= test1_t_foo_value_1 0)
 	(<= test1_t_foo_value_2 0)
 	(<= test1_t_foo_value_3 0)
 	(<= test1_t_foo_value_4 0)
-	(<= test1_t_foo_value_5 0)))`
+	(<= test1_t_foo_value_5 0)) :named assert_0))`
 
 	smt := prepTest("", test, true, false)
 
@@ -301,12 +307,12 @@ func TestMultiVar(t *testing.T) {
 	(assert (= test1_t_foo_value_3 (- test1_t_foo_value_2 2.0)))
 	(assert (= test1_t_foo_value_4 (- test1_t_foo_value_3 2.0)))
 	(assert (= test1_t_foo_value_5 (- test1_t_foo_value_4 2.0)))
-	(assert( or (<= test1_t_foo_value_0 test1_t_fuzz_0)
+	(assert(! ( or (<= test1_t_foo_value_0 test1_t_fuzz_0)
 	(<= test1_t_foo_value_1 test1_t_fuzz_0)
 	(<= test1_t_foo_value_2 test1_t_fuzz_0)
 	(<= test1_t_foo_value_3 test1_t_fuzz_0)
 	(<= test1_t_foo_value_4 test1_t_fuzz_0)
-	(<= test1_t_foo_value_5 test1_t_fuzz_0)))
+	(<= test1_t_foo_value_5 test1_t_fuzz_0)) :named assert_0))
 	`
 
 	smt := prepTest("", test, true, false)
@@ -352,7 +358,7 @@ func TestSimpleAssume(t *testing.T) {
 	(assert (= test1_t_foo_value_3 (- test1_t_foo_value_2 2.0)))
 	(assert (= test1_t_foo_value_4 (- test1_t_foo_value_3 2.0)))
 	(assert (= test1_t_foo_value_5 (- test1_t_foo_value_4 2.0)))
-	(assert (and (> test1_t_foo_value_0 0) (> test1_t_foo_value_1 0)(> test1_t_foo_value_2 0)(> test1_t_foo_value_3 0)(> test1_t_foo_value_4 0)(> test1_t_foo_value_5 0)))
+	(assert (! (and (> test1_t_foo_value_0 0) (> test1_t_foo_value_1 0)(> test1_t_foo_value_2 0)(> test1_t_foo_value_3 0)(> test1_t_foo_value_4 0)(> test1_t_foo_value_5 0)) :named assume_0))
 `
 
 	smt := prepTest("", test, true, false)
@@ -398,7 +404,7 @@ func TestSpecificStateAssume(t *testing.T) {
 	(assert (= test1_t_foo_value_3 (- test1_t_foo_value_2 2.0)))
 	(assert (= test1_t_foo_value_4 (- test1_t_foo_value_3 2.0)))
 	(assert (= test1_t_foo_value_5 (- test1_t_foo_value_4 2.0)))
-	(assert (> test1_t_foo_value_1 0))
+	(assert (! (> test1_t_foo_value_1 0) :named assume_0))
 `
 
 	smt := prepTest("", test, true, false)
@@ -409,3 +415,499 @@ func TestSpecificStateAssume(t *testing.T) {
 		t.Fatalf(err.Error())
 	}
 }
+
+func TestProperties(t *testing.T) {
+	test := `spec test1;
+	
+	def amount = stock{
+		value: 10,
+	};
+	
+	def test = flow{
+		foo: new amount,
+		bar: func{
+			foo.value -> 2;
+		},
+	};
+
+	assert amount.value > 0;
+	assume amount.value < 100;
+
+	for 2 run {
+		t = new test;
+		t.bar;
+	};
+	`
+	flags := map[string]bool{"specType": true, "testing": false, "skipRun": false}
+	l := listener.Execute(test, "", flags)
+	pre := preprocess.Execute(l)
+	ty := types.Execute(pre.Processed, pre.Specs)
+	compiler := llvm.Execute(ty.Checked, ty.SpecStructs, l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, true)
+	g := Execute(compiler)
+
+	if len(g.Properties) != 2 {
+		t.Fatalf("wrong number of properties. want=2 got=%d", len(g.Properties))
+	}
+
+	a := g.Properties[0]
+	if a.Name != "assert_0" || a.Assume || a.Line() != 14 {
+		t.Fatalf("assert property is incorrect. got=%+v", a)
+	}
+
+	if len(a.Clauses) != 3 || a.Clauses[2] != "(<= test1_t_foo_value_2 0)" {
+		t.Fatalf("assert clauses are incorrect. got=%v", a.Clauses)
+	}
+
+	if a.Round(0) != 0 || a.Round(2) != 1 {
+		t.Fatalf("assert rounds are incorrect. got=%v", a.Rounds)
+	}
+
+	if a.Antecedent != "" {
+		t.Fatalf("assert without a when has an antecedent. got=%s", a.Antecedent)
+	}
+
+	if !strings.Contains(g.SMT(), ":named assert_0") {
+		t.Fatalf("assert not named in the SMT. got=%s", g.SMT())
+	}
+
+	b := g.Properties[1]
+	if b.Name != "assume_0" || !b.Assume || b.Line() != 15 {
+		t.Fatalf("assume property is incorrect. got=%+v", b)
+	}
+}
+
+func TestAntecedent(t *testing.T) {
+	test := `system test1;
+		component a = states{
+			foo: func{
+				advance(b.bar);
+			},
+			zoo: func{
+				advance(this.foo);
+			},
+		};
+
+		component b = states{
+			buzz: func{
+				advance(a.foo);
+			},
+			bar: func{
+				stay();
+			},
+		};
+
+		assert when a.zoo then !b.bar;
+
+		start{
+			b: buzz,
+			a: zoo,
+		};
+		`
+	flags := map[string]bool{"specType": false, "testing": false, "skipRun": false}
+	l := listener.Execute(test, "", flags)
+	pre := preprocess.Execute(l)
+	ty := types.Execute(pre.Processed, pre.Specs)
+	compiler := llvm.Execute(ty.Checked, ty.SpecStructs, l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, true)
+	g := Execute(compiler)
+
+	if len(g.Properties) != 1 {
+		t.Fatalf("wrong number of properties. want=1 got=%d", len(g.Properties))
+	}
+
+	a := g.Properties[0].Antecedent
+	if !strings.Contains(a, "test1_a_zoo_") || strings.Contains(a, "test1_b_bar_") {
+		t.Fatalf("when/then antecedent is incorrect. got=%s", a)
+	}
+}
+
+func TestRoundTemporal(t *testing.T) {
+	test := `spec test1;
+
+	def amount = stock{
+		value: 10,
+	};
+
+	def test = flow{
+		foo: new amount,
+		bar: func{
+			foo.value -> 2;
+		},
+	};
+
+	assert amount.value > 0 %s;
+
+	for 3 run {
+		t = new test;
+		t.bar;
+	};
+	`
+	// A constant puts a setup round before the run block,
+	// the windows still count the run block's rounds
+	withConst := `spec test1;
+
+	const drain = 2;
+
+	def amount = stock{
+		value: 10,
+	};
+
+	def test = flow{
+		foo: new amount,
+		bar: func{
+			foo.value -> drain;
+		},
+	};
+
+	assert amount.value > 0 %s;
+
+	for 3 run {
+		t = new test;
+		t.bar;
+	};
+	`
+	tests := []struct {
+		spec     string
+		temporal string
+		want     string
+	}{
+		{test, "next", "(not (> test1_t_foo_value_2 0))"},
+		{test, "within 2", "(and (not (> test1_t_foo_value_0 0)) (not (> test1_t_foo_value_1 0)) (not (> test1_t_foo_value_2 0)))"},
+		{test, "between 2, 3", "(or (not (> test1_t_foo_value_2 0)) (not (> test1_t_foo_value_3 0)))"},
+		{test, "until amount.value < 5", "(not (or (or (< test1_t_foo_value_0 5) (< test1_t_foo_value_1 5)) (and (< test1_t_foo_value_2 5) (and (> test1_t_foo_value_0 0) (> test1_t_foo_value_1 0))) (and (< test1_t_foo_value_3 5) (and (> test1_t_foo_value_0 0) (> test1_t_foo_value_1 0) (> test1_t_foo_value_2 0)))))"},
+		{withConst, "next", "(not (> test1_t_foo_value_2 0))"},
+		{withConst, "within 1", "(and (not (> test1_t_foo_value_0 0)) (not (> test1_t_foo_value_1 0)))"},
+		{withConst, "between 1, 1", "(or (not (> test1_t_foo_value_0 0)) (not (> test1_t_foo_value_1 0)))"},
+		{withConst, "between 2, 3", "(or (not (> test1_t_foo_value_2 0)) (not (> test1_t_foo_value_3 0)))"},
+	}
+
+	for _, tt := range tests {
+		flags := map[string]bool{"specType": true, "testing": false, "skipRun": false}
+		l := listener.Execute(fmt.Sprintf(tt.spec, tt.temporal), "", flags)
+		pre := preprocess.Execute(l)
+		ty := types.Execute(pre.Processed, pre.Specs)
+		compiler := llvm.Execute(ty.Checked, ty.SpecStructs, l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, true)
+		g := Execute(compiler)
+
+		if len(g.Properties) != 1 || g.Properties[0].Rule != tt.want {
+			t.Fatalf("%s assert is incorrect.\nwant=%s\ngot=%+v", tt.temporal, tt.want, g.Properties)
+		}
+
+		if !g.Properties[0].Temporal {
+			t.Fatalf("%s assert not marked temporal", tt.temporal)
+		}
+	}
+}
+
+func TestQuantifiers(t *testing.T) {
+	test := `spec test1;
+
+	def amount = stock{
+		value: 10,
+	};
+
+	def test = flow{
+		foo: new amount,
+		bar: func{
+			foo.value -> 2;
+		},
+	};
+
+	assert %[1]s amount.value > 0;
+	assume %[1]s amount.value < 9;
+
+	for 1 run {
+		t1 = new test;
+		t2 = new test;
+		t1.bar;
+		t2.bar;
+	};
+	`
+	all := []string{
+		"(or (<= test1_t1_foo_value_0 0) (<= test1_t1_foo_value_1 0) (<= test1_t2_foo_value_0 0) (<= test1_t2_foo_value_1 0))",
+		"(and (< test1_t1_foo_value_0 9) (< test1_t1_foo_value_1 9) (< test1_t2_foo_value_0 9) (< test1_t2_foo_value_1 9))",
+	}
+	tests := []struct {
+		quantifier     string
+		assert, assume string
+	}{
+		{"", all[0], all[1]}, // all is the default
+		{"all", all[0], all[1]},
+		{"any",
+			"(and (or (<= test1_t1_foo_value_0 0) (<= test1_t1_foo_value_1 0)) (or (<= test1_t2_foo_value_0 0) (<= test1_t2_foo_value_1 0)))",
+			"(or (and (< test1_t1_foo_value_0 9) (< test1_t1_foo_value_1 9)) (and (< test1_t2_foo_value_0 9) (< test1_t2_foo_value_1 9)))"},
+	}
+
+	for _, tt := range tests {
+		flags := map[string]bool{"specType": true, "testing": false, "skipRun": false}
+		l := listener.Execute(fmt.Sprintf(test, tt.quantifier), "", flags)
+		pre := preprocess.Execute(l)
+		ty := types.Execute(pre.Processed, pre.Specs)
+		compiler := llvm.Execute(ty.Checked, ty.SpecStructs, l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, true)
+		g := Execute(compiler)
+
+		if len(g.Properties) != 2 {
+			t.Fatalf("wrong number of properties. want=2 got=%d", len(g.Properties))
+		}
+
+		// Instances come out of a map, so all isn't ordered
+		if g.Properties[0].Rule != tt.assert && !notStrictlyOrdered(tt.assert, g.Properties[0].Rule) {
+			t.Fatalf("%s assert is incorrect.\nwant=%s\ngot=%s", tt.quantifier, tt.assert, g.Properties[0].Rule)
+		}
+
+		if g.Properties[1].Rule != tt.assume && !notStrictlyOrdered(tt.assume, g.Properties[1].Rule) {
+			t.Fatalf("%s assume is incorrect.\nwant=%s\ngot=%s", tt.quantifier, tt.assume, g.Properties[1].Rule)
+		}
+	}
+}
+
+func TestSplitClauses(t *testing.T) {
+	c := splitClauses("(or (<= x_0 0) (and (> y_1 2) (< y_1 3)) z_2)")
+	if len(c) != 3 || c[1] != "(and (> y_1 2) (< y_1 3))" || c[2] != "z_2" {
+		t.Fatalf("clauses split incorrectly. got=%v", c)
+	}
+
+	c = splitClauses("(and (<= x_0 0) (<= x_1 0))")
+	if len(c) != 1 {
+		t.Fatalf("clauses of a conjunction split. got=%v", c)
+	}
+}
+
+func TestPerProperty(t *testing.T) {
+	g := NewGenerator()
+	g.asserts = []string{"(assert (! (<= x_0 0) :named assert_0))"}
+	g.assumes = []string{"(assert (! (> x_0 5) :named assume_0))"}
+
+	if !strings.Contains(g.SMT(), "assert_0") {
+		t.Fatalf("asserts missing from the SMT. got=%s", g.SMT())
+	}
+
+	g.PerProperty = true
+	if strings.Contains(g.SMT(), "assert_0") || !strings.Contains(g.SMT(), "assume_0") {
+		t.Fatalf("per property SMT should only have the assumes. got=%s", g.SMT())
+	}
+}
+
+func TestAutoProps(t *testing.T) {
+	tests := []struct {
+		spec     string
+		specType bool
+		rules    []string
+		labels   []string
+	}{
+		{`spec test1;
+
+	def amount = stock{
+		value: natural(10),
+		rate: 2,
+	};
+
+	def test = flow{
+		foo: new amount,
+		bar: func{
+			foo.value -> foo.value / foo.rate;
+		},
+	};
+
+	for 1 run {
+		t = new test;
+		t.bar;
+	};
+	`, true,
+			[]string{"(or (< test1_t_foo_value_0 0) (< test1_t_foo_value_1 0))", "(= test1_t_foo_rate_0 0)"},
+			[]string{"t.foo.value stays natural", "t.foo.rate is never a zero divisor"}},
+		{`spec test1;
+
+	def amount = stock{
+		value: 10,
+		a: 4,
+		b: 2,
+	};
+
+	def test = flow{
+		foo: new amount,
+		bar: func{
+			foo.value -> foo.value / (foo.a - foo.b);
+		},
+	};
+
+	for 1 run {
+		t = new test;
+		t.bar;
+	};
+	`, true,
+			[]string{"(= (- test1_t_foo_a_0 test1_t_foo_b_0) 0)"},
+			[]string{"t.foo.a - t.foo.b is never a zero divisor"}},
+		{`system test1;
+
+	component a = states{
+		x: 8,
+		y: 2,
+		foo: func{
+			if this.x / this.y * 2 > 3 {
+				stay();
+			}
+		},
+	};
+
+	start{
+		a: foo,
+	};
+	`, false,
+			[]string{"(= test1_a_y_0 0)"},
+			[]string{"a.y is never a zero divisor"}},
+		{`system test1;
+
+	component a = states{
+		foo: func{
+			advance(this.zoo);
+		},
+		zoo: func{
+			stay();
+		},
+	};
+
+	start{
+		a: foo,
+	};
+	`, false,
+			[]string{"(or (and (not test1_a_foo_3) (not test1_a_zoo_2)) (and test1_a_foo_3 test1_a_zoo_2))"},
+			[]string{"a has one active state"}},
+	}
+
+	for _, tt := range tests {
+		flags := map[string]bool{"specType": tt.specType, "testing": false, "skipRun": false}
+		l := listener.Execute(tt.spec, "", flags)
+		pre := preprocess.Execute(l)
+		ty := types.Execute(pre.Processed, pre.Specs)
+		compiler := llvm.Execute(ty.Checked, ty.SpecStructs, l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, true)
+		compiler.AddAutoProps()
+		g := Execute(compiler)
+
+		if len(g.Properties) != len(tt.rules) {
+			t.Fatalf("wrong number of properties. want=%d got=%d", len(tt.rules), len(g.Properties))
+		}
+
+		for i, p := range g.Properties {
+			if p.Rule != tt.rules[i] {
+				t.Fatalf("auto property %d is incorrect.\nwant=%s\ngot=%s", i, tt.rules[i], p.Rule)
+			}
+
+			if p.Label != tt.labels[i] {
+				t.Fatalf("auto property %d has the wrong label. want=%s got=%s", i, tt.labels[i], p.Label)
+			}
+		}
+	}
+}
+
+func TestLiveness(t *testing.T) {
+	test := `system test1;
+
+	component a = states{
+		foo: func{
+			advance(this.zoo);
+		},
+		zoo: func{
+			stay();
+		},
+	};
+
+	start{
+		a: foo,
+	};
+
+	for 2 run {};
+	`
+	flags := map[string]bool{"specType": false, "testing": false, "skipRun": false}
+	l := listener.Execute(test, "", flags)
+	pre := preprocess.Execute(l)
+	ty := types.Execute(pre.Processed, pre.Specs)
+	compiler := llvm.Execute(ty.Checked, ty.SpecStructs, l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, true)
+	if compiler.AddLivenessProps([]string{"a.bar"}) == nil {
+		t.Fatal("unknown progress state not caught")
+	}
+
+	err := compiler.AddLivenessProps([]string{"a.foo"})
+	if err != nil {
+		t.Fatalf("adding liveness asserts failed. got=%s", err)
+	}
+	g := Execute(compiler)
+
+	if len(g.Properties) != 2 {
+		t.Fatalf("wrong number of properties. want=2 got=%d", len(g.Properties))
+	}
+
+	// Stuck in zoo from the second round on
+	deadlock := "(or (or (and (= test1_a_foo_1 test1_a_foo_3) (= test1_a_zoo_0 test1_a_zoo_2)) (and (not test1_a_foo_3) (not test1_a_zoo_2))) (or (and (= test1_a_foo_3 test1_a_foo_5) (= test1_a_zoo_2 test1_a_zoo_4)) (and (not test1_a_foo_5) (not test1_a_zoo_4))))"
+	if g.Properties[0].Rule != deadlock {
+		t.Fatalf("deadlock rule is incorrect.\nwant=%s\ngot=%s", deadlock, g.Properties[0].Rule)
+	}
+
+	livelock := "(or (and (= test1_a_foo_1 test1_a_foo_3) (= test1_a_zoo_0 test1_a_zoo_2) (not test1_a_foo_3)) (and (= test1_a_foo_1 test1_a_foo_5) (= test1_a_zoo_0 test1_a_zoo_4) (not test1_a_foo_3) (not test1_a_foo_5)) (and (= test1_a_foo_3 test1_a_foo_5) (= test1_a_zoo_2 test1_a_zoo_4) (not test1_a_foo_5)))"
+	if g.Properties[1].Rule != livelock {
+		t.Fatalf("livelock rule is incorrect.\nwant=%s\ngot=%s", livelock, g.Properties[1].Rule)
+	}
+
+	if g.Properties[1].Label != "no livelock, every cycle visits a.foo" {
+		t.Fatalf("livelock label is incorrect. got=%s", g.Properties[1].Label)
+	}
+}
+
+func TestReachQueries(t *testing.T) {
+	test := `system test1;
+
+	component a = states{
+		ready: false,
+		idle: func{
+			if this.ready {
+				advance(this.busy);
+			}
+		},
+		busy: func{
+			advance(this.idle);
+		},
+	};
+
+	start{
+		a: idle,
+	};
+
+	for 2 run {};
+	`
+	flags := map[string]bool{"specType": false, "testing": false, "skipRun": false}
+	l := listener.Execute(test, "", flags)
+	pre := preprocess.Execute(l)
+	ty := types.Execute(pre.Processed, pre.Specs)
+	compiler := llvm.Execute(ty.Checked, ty.SpecStructs, l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, true)
+	g := Execute(compiler)
+
+	states, transitions := compiler.ReachQueries()
+	queries := append(g.Queries(states), g.Queries(transitions)...)
+
+	want := []struct {
+		label string
+		rule  string
+	}{
+		{"a.idle", "(or test1_a_idle_1 test1_a_idle_5 test1_a_idle_9)"},
+		{"a.busy", "(or test1_a_busy_0 test1_a_busy_4 test1_a_busy_8)"},
+		{"a.idle -> a.busy", "(or (and test1_a_idle_1 (not test1_a_idle_5) test1_a_busy_4) (and test1_a_idle_5 (not test1_a_idle_9) test1_a_busy_8))"},
+		{"a.busy -> a.idle", "(or (and test1_a_busy_0 (not test1_a_busy_4) test1_a_idle_5) (and test1_a_busy_4 (not test1_a_busy_8) test1_a_idle_9))"},
+	}
+
+	if len(queries) != len(want) {
+		t.Fatalf("wrong number of queries. want=%d got=%d", len(want), len(queries))
+	}
+
+	for i, q := range queries {
+		if q.Label != want[i].label || q.Rule != want[i].rule {
+			t.Fatalf("query %d is incorrect.\nwant=%s %s\ngot=%s %s", i, want[i].label, want[i].rule, q.Label, q.Rule)
+		}
+	}
+
+	if queries[2].Line() != 7 {
+		t.Fatalf("transition should point at its advance call. got=%d", queries[2].Line())
+	}
+
+	if strings.Contains(g.SMT(), "test1_a_idle_1 test1_a_idle_5") {
+		t.Fatal("queries should not be written into the SMT")
+	}
+}
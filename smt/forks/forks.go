@@ -57,3 +57,24 @@ func (c *Choice) AddChoiceValue(n int16) *Choice {
 func (c *Choice) GetEnd() int16 {
 	return c.Values[len(c.Values)-1]
 }
+
+// A fork the solver settles differently in each scenario:
+// the order a parallel run went in or the branch a
+// conditional took. Tests[i] is true in the model when
+// option i was the one taken.
+type Decision struct {
+	Round    int
+	Function string     // conditionals, the function the if is in
+	Cond     string     // conditionals, the SMT of the test
+	Orders   [][]string // parallel runs, the calls of each option
+	Tests    []string
+
+	// A conditional inside a parallel run only
+	// counts when its option was the one taken
+	Parent *Decision
+	Option int
+}
+
+func (d *Decision) Parallel() bool {
+	return len(d.Orders) > 0
+}
@@ -5,10 +5,13 @@ import (
 	"fault/ast"
 	"fault/llvm"
 	"fault/smt/forks"
+	"fault/smt/properties"
 	"fault/smt/rules"
 	"fault/smt/variables"
 	"fault/util"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -27,31 +30,46 @@ type Generator struct {
 	// Raw input
 	Uncertains map[string][]float64
 	Unknowns   []string
+	Ranges     map[string][]float64 // bounds on unknowns
 	functions  map[string]*ir.Func
 	rawAsserts []*ast.AssertionStatement
 	rawAssumes []*ast.AssertionStatement
 	rawRules   [][]rules.Rule
 
+	// Which distribution each uncertain value comes
+	// from, missing entries are normal
+	Distributions map[string]*ast.Uncertain
+
 	// Generated SMT
 	inits     []string
 	constants []string
 	rules     []string
 	asserts   []string
+	assumes   []string
 
 	variables      *variables.VarData
 	blocks         map[string][]rules.Rule
 	localCallstack []string
 
 	forks            []forks.Fork
+	decisions        []*forks.Decision
+	inOption         *forks.Decision // parallel run the rules belong to
+	optionId         int
 	storedChoice     map[string]*rules.StateChange
 	inPhiState       *forks.PhiState //Flag, are we in a conditional or parallel?
 	parallelGrouping string
 	parallelRunStart bool            //Flag, make sure all branches with parallel runs begin from the same point
 	returnVoid       *forks.PhiState //Flag, escape parseFunc before moving to next block
 
+	Optimize    bool // Add an objective for the most likely failure
+	PerProperty bool // Leave the asserts out, they're checked one at a time
+
+	Properties []*properties.Property // asserts and assumes by name
+
 	Rounds     int
 	RoundVars  [][][]string
 	RVarLookup map[string][][]int
+	RunRounds  []int // of each of RoundVars in the run block from 1, 0 is the setup before it
 	Results    map[string][]*variables.VarChange
 }
 
@@ -73,6 +91,8 @@ func NewGenerator() *Generator {
 func Execute(compiler *llvm.Compiler) *Generator {
 	generator := NewGenerator()
 	generator.LoadMeta(compiler.RunRound, compiler.Uncertains, compiler.Unknowns, compiler.Asserts, compiler.Assumes)
+	generator.Ranges = compiler.Ranges
+	generator.Distributions = compiler.Distributions
 	generator.Run(compiler.GetIR())
 	return generator
 }
@@ -101,10 +121,29 @@ func (g *Generator) Run(llopt string) {
 
 func (g *Generator) newRound() {
 	g.RoundVars = append(g.RoundVars, [][]string{})
+	g.RunRounds = append(g.RunRounds, g.lastRunRound()+1)
 }
 
 func (g *Generator) initVarRound(base string, num int) {
+	// Set before the run block (constants, start states)
 	g.RoundVars = [][][]string{{{base, fmt.Sprint(num)}}}
+	g.RunRounds = []int{0}
+}
+
+func (g *Generator) lastRunRound() int {
+	if len(g.RunRounds) == 0 {
+		return 0
+	}
+	return g.RunRounds[len(g.RunRounds)-1]
+}
+
+func (g *Generator) specRound(r int) int {
+	// Round r of RoundVars as the spec counts it, the
+	// setup is round 0 and the run block starts at 1
+	if r >= 0 && r < len(g.RunRounds) {
+		return g.RunRounds[r]
+	}
+	return r + 1
 }
 
 func (g *Generator) currentRound() int {
@@ -174,6 +213,10 @@ func (g *Generator) GetForks() []forks.Fork {
 	return g.forks
 }
 
+func (g *Generator) GetDecisions() []*forks.Decision {
+	return g.decisions
+}
+
 func (g *Generator) getCurrentFork() forks.Fork {
 	return g.forks[len(g.forks)-1]
 }
@@ -228,18 +271,19 @@ func (g *Generator) newConstants(globals []*ir.Global) []string {
 }
 
 func (g *Generator) newAssumes(asserts []*ast.AssertionStatement) {
-	for _, v := range asserts {
+	for i, v := range asserts {
 		a := g.parseAssert(v)
-		rule := g.writeAssert("", a)
-		g.asserts = append(g.asserts, rule)
+		p := g.newProperty(fmt.Sprintf("assume_%d", i), v, a)
+		g.assumes = append(g.assumes, g.writeAssert("", p.Named()))
 	}
 }
 
 func (g *Generator) newAsserts(asserts []*ast.AssertionStatement) {
 	var arule []string
-	for _, v := range asserts {
+	for i, v := range asserts {
 		a := g.parseAssert(v)
-		arule = append(arule, a)
+		p := g.newProperty(fmt.Sprintf("assert_%d", i), v, a)
+		arule = append(arule, p.Named())
 	}
 
 	if len(arule) == 0 {
@@ -253,6 +297,50 @@ func (g *Generator) newAsserts(asserts []*ast.AssertionStatement) {
 	}
 }
 
+func (g *Generator) newProperty(name string, a *ast.AssertionStatement, rule string) *properties.Property {
+	p := &properties.Property{
+		Name:     name,
+		Position: a.Position(),
+		Assume:   a.Assume,
+		Rule:     rule,
+		Clauses:  splitClauses(rule),
+		Temporal: a.Temporal != "" || a.TemporalFilter != "",
+		Label:    a.Label,
+	}
+
+	for _, c := range p.Clauses {
+		p.Rounds = append(p.Rounds, g.clauseRound(c))
+	}
+
+	if a.Constraint.Operator == "then" {
+		left := g.parseInvariantNode(a.Constraint.Left, true)
+		p.Antecedent = g.joinStates(left, "or")
+	}
+	g.Properties = append(g.Properties, p)
+	return p
+}
+
+func (g *Generator) Queries(queries []*ast.AssertionStatement) []*properties.Property {
+	// Rules to find a model of, not asserts,
+	// so they aren't written into the SMT
+	var ps []*properties.Property
+	for i, q := range queries {
+		rule := g.parseAssert(q)
+		p := &properties.Property{
+			Name:     fmt.Sprintf("query_%d", i),
+			Label:    q.Label,
+			Position: q.Position(),
+			Rule:     rule,
+			Clauses:  splitClauses(rule),
+		}
+		for _, c := range p.Clauses {
+			p.Rounds = append(p.Rounds, g.clauseRound(c))
+		}
+		ps = append(ps, p)
+	}
+	return ps
+}
+
 func (g *Generator) sortFuncs(funcs []*ir.Func) {
 	//Iterate through all the function blocks and store them by
 	// function call name.
@@ -411,6 +499,7 @@ func (g *Generator) parseTermCon(term *ir.TermCondBr) []rules.Rule {
 		fEnds = append(fEnds, syncs["false"]...)
 
 		ru = append(ru, &rules.Ite{Cond: cond, T: tEnds, F: fEnds})
+		g.condDecision(cond)
 		g.inPhiState.Out()
 	}
 
@@ -1091,6 +1180,64 @@ func (g *Generator) declareVar(id string, t string) {
 	g.inits = append(g.inits, def)
 }
 
+func (g *Generator) writeRange(id string) string {
+	// unknown(min, max[, step]) keeps the solver inside
+	// the bounds, a step lists every value allowed
+	base, _ := g.variables.GetVarBase(id)
+	r, ok := g.Ranges[base]
+	if !ok || len(r) < 2 {
+		return g.writeSupport(id, base)
+	}
+	return g.writeBounds(id, r)
+}
+
+const poissonValues = 1000 // most values listed for a poisson
+
+func (g *Generator) writeSupport(id string, base string) string {
+	// An uncertain value can only be what its distribution
+	// draws, otherwise the solver picks scenarios that can't
+	// happen. Normals take any value.
+	u, ok := g.Distributions[base]
+	if !ok {
+		return ""
+	}
+
+	p := u.Params
+	switch u.Distribution {
+	case "uniform":
+		return g.writeBounds(id, p)
+	case "beta":
+		return g.writeBounds(id, []float64{0, 1})
+	case "exponential":
+		return g.writeAssert("", fmt.Sprintf("(>= %s 0.0)", id))
+	case "lognormal":
+		return g.writeAssert("", fmt.Sprintf("(> %s 0.0)", id))
+	case "poisson":
+		// Whole numbers of events, up to where the
+		// chance of more is vanishingly small
+		max := math.Ceil(p[0] + 10*math.Sqrt(p[0]) + 10)
+		if max >= poissonValues {
+			return g.writeAssert("", fmt.Sprintf("(>= %s 0.0)", id))
+		}
+		return g.writeBounds(id, []float64{0, max, 1})
+	}
+	return ""
+}
+
+func (g *Generator) writeBounds(id string, r []float64) string {
+	bounds := fmt.Sprintf("(>= %s %s) (<= %s %s)", id, smtFloat(r[0]), id, smtFloat(r[1]))
+	steps := util.RangeSteps(r)
+	if len(steps) == 0 {
+		return g.writeAssert("and", bounds)
+	}
+
+	var eqs []string
+	for _, v := range steps {
+		eqs = append(eqs, fmt.Sprintf("(= %s %s)", id, smtFloat(v)))
+	}
+	return g.writeAssert("and", fmt.Sprintf("%s (or %s)", bounds, strings.Join(eqs, " ")))
+}
+
 func (g *Generator) writeAssert(op string, stmt string) string {
 	if op == "" {
 		return fmt.Sprintf("(assert %s)", stmt)
@@ -1111,9 +1258,9 @@ func (g *Generator) writeRule(ru rules.Rule) string {
 		y := g.unpackRule(r.Y)
 		x := g.unpackRule(r.X)
 
-		if y == "0x3DA3CA8CB153A753" { //An uncertain or unknown value
+		if r.Free || y == "0x3DA3CA8CB153A753" { //An uncertain or unknown value
 			g.declareVar(x, r.Ty)
-			return ""
+			return g.writeRange(x)
 		}
 
 		if r.Op == "or" {
@@ -1260,6 +1407,17 @@ func (g *Generator) constantRule(id string, c constant.Constant) string {
 			}
 			return g.writeInitRule(id, ty, v+".0")
 		}
+	case *constant.Int:
+		if val.Typ.BitSize != 1 { // Only bools are constants
+			return ""
+		}
+		g.addVarToRound(id, 0)
+		id = g.variables.AdvanceSSA(id)
+		if g.isASolvable(id) {
+			g.declareVar(id, "Bool")
+		} else {
+			return g.writeInitRule(id, "Bool", val.Ident())
+		}
 	}
 	return ""
 }
@@ -1337,6 +1495,10 @@ func (g *Generator) storeRule(inst *ir.InstStore) []rules.Rule {
 		}
 	} else {
 		ty := g.variables.LookupType(base, inst.Src)
+		_, stored := g.variables.SSA[base]
+		// Booleans have no dummy value, an uncertain
+		// bool is free from its first state
+		free := !stored && ty == "Bool" && g.isASolvable(fmt.Sprintf("%s_0", base))
 		n := g.variables.SSA[base]
 		prev := fmt.Sprintf("%s_%d", base, n)
 		if !g.inPhiState.Check() {
@@ -1347,7 +1509,11 @@ func (g *Generator) storeRule(inst *ir.InstStore) []rules.Rule {
 		id := g.variables.AdvanceSSA(base)
 		g.addVarToRound(base, int(g.variables.SSA[base]))
 		g.AddNewVarChange(base, id, prev)
-		ru = append(ru, g.createRule(id, inst.Src.Ident(), ty, ""))
+		if free {
+			ru = append(ru, &rules.Infix{X: &rules.Wrap{Value: id}, Ty: ty, Y: &rules.Wrap{}, Free: true})
+		} else {
+			ru = append(ru, g.createRule(id, inst.Src.Ident(), ty, ""))
+		}
 	}
 	return ru
 }
@@ -1509,7 +1675,18 @@ func (g *Generator) SMT() string {
 	out.WriteString(strings.Join(g.inits, "\n"))
 	out.WriteString(strings.Join(g.constants, "\n"))
 	out.WriteString(strings.Join(g.rules, "\n"))
-	out.WriteString(strings.Join(g.asserts, "\n"))
+	if g.PerProperty {
+		out.WriteString(strings.Join(g.assumes, "\n"))
+	} else {
+		out.WriteString(strings.Join(append(append([]string{}, g.asserts...), g.assumes...), "\n"))
+	}
+
+	if g.Optimize {
+		if obj := g.Objective(); obj != "" {
+			out.WriteString("\n")
+			out.WriteString(obj)
+		}
+	}
 
 	return out.String()
 }
@@ -1541,7 +1718,10 @@ func (g *Generator) runParallel(perm [][]string) []rules.Rule {
 	g.branchId = g.branchId + 1
 	branch := fmt.Sprint("branch_", g.branchId)
 	g.newFork()
+	d := &forks.Decision{Round: g.currentRound(), Orders: perm}
+	parent, parentId := g.inOption, g.optionId
 	for i, calls := range perm {
+		g.inOption, g.optionId = d, i
 		branchBlock := fmt.Sprint("option_", i)
 		var opts [][]rules.Rule
 		varState := g.variables.SaveState()
@@ -1563,10 +1743,67 @@ func (g *Generator) runParallel(perm [][]string) []rules.Rule {
 		ru = append(ru, raw...)
 	}
 
-	ru = append(ru, g.capParallel()...)
+	g.inOption, g.optionId = parent, parentId
+	d.Parent, d.Option = parent, parentId
+
+	phis := g.capParallel()
+	g.parallelDecision(d, phis)
+	ru = append(ru, phis...)
 	return ru
 }
 
+func (g *Generator) condDecision(cond rules.Rule) {
+	var test string
+	switch c := cond.(type) {
+	case *rules.Infix:
+		test = g.writeCond(c)
+	case *rules.Wrap:
+		test = c.Value
+	default:
+		return
+	}
+
+	g.decisions = append(g.decisions, &forks.Decision{
+		Round:    g.currentRound(),
+		Function: g.currentFunction,
+		Cond:     test,
+		Tests:    []string{test, fmt.Sprintf("(not %s)", test)},
+		Parent:   g.inOption,
+		Option:   g.optionId,
+	})
+}
+
+func (g *Generator) parallelDecision(d *forks.Decision, phis []rules.Rule) {
+	// Each phi picks between the end values of the options,
+	// an option was taken when every phi picked its value
+	var caps []*rules.Phi
+	for _, r := range phis {
+		p := r.(*rules.Phi)
+		if len(p.Nums) != len(d.Orders) { // Choices don't line up with the options
+			return
+		}
+		caps = append(caps, p)
+	}
+
+	if len(caps) == 0 {
+		return
+	}
+	sort.Slice(caps, func(i, j int) bool { return caps[i].BaseVar < caps[j].BaseVar })
+
+	for i := range d.Orders {
+		var eqs []string
+		for _, p := range caps {
+			eqs = append(eqs, fmt.Sprintf("(= %s %s_%d)", p.EndState, p.BaseVar, p.Nums[i]))
+		}
+		if len(eqs) == 1 {
+			d.Tests = append(d.Tests, eqs[0])
+		} else {
+			d.Tests = append(d.Tests, g.writeAssertlessRule("and", strings.Join(eqs, " "), ""))
+		}
+	}
+	g.decisions = append(g.decisions, d)
+}
+
 func (g *Generator) parallelRules(r [][]rules.Rule) []rules.Rule {
 	var rules []rules.Rule
 	for _, op := range r {
@@ -49,7 +49,7 @@ func TestEventually(t *testing.T) {
 	(assert (= test1_t_foo_value_3 (- test1_t_foo_value_2 2.0)))
 	(assert (= test1_t_foo_value_4 (- test1_t_foo_value_3 2.0)))
 	(assert (= test1_t_foo_value_5 (- test1_t_foo_value_4 2.0)))
-	(assert (or (> test1_t_foo_value_0 0) (> test1_t_foo_value_1 0)(> test1_t_foo_value_2 0)(> test1_t_foo_value_3 0)(> test1_t_foo_value_4 0)(> test1_t_foo_value_5 0)))
+	(assert (! (or (> test1_t_foo_value_0 0) (> test1_t_foo_value_1 0)(> test1_t_foo_value_2 0)(> test1_t_foo_value_3 0)(> test1_t_foo_value_4 0)(> test1_t_foo_value_5 0)) :named assume_0))
 `
 
 	smt := prepTest("", test, true, false)
@@ -61,6 +61,129 @@ func TestEventually(t *testing.T) {
 	}
 }
 
+func TestBernoulli(t *testing.T) {
+	test := `spec test1;
+
+	const flag = uncertain(bernoulli, 0.3);
+
+	def faucet = flow{
+		water: new tub,
+		in: func{
+			if flag {
+				water.level <- 10;
+			}
+		},
+	};
+
+	def tub = stock{
+		level: 5,
+	};
+
+	for 1 run {
+		drawn = new faucet;
+		drawn.in;
+	};
+	`
+	expecting := `(set-logic QF_NRA)
+	(declare-fun test1_flag_0 () Bool)
+	(declare-fun test1_drawn_water_level_2 () Real)
+	(declare-fun test1_drawn_water_level_0 () Real)
+	(declare-fun test1_drawn_water_level_1 () Real)
+	(assert (= test1_drawn_water_level_0 5.0))
+	(assert (= test1_drawn_water_level_1 (+ test1_drawn_water_level_0 10.0)))
+	(assert (ite (= test1_flag_0 true) (= test1_drawn_water_level_2 test1_drawn_water_level_1) (= test1_drawn_water_level_2 test1_drawn_water_level_0)))
+`
+
+	smt := prepTest("", test, true, false)
+
+	err := compareResults("Bernoulli", smt, expecting)
+
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+}
+
+func TestUnknownRange(t *testing.T) {
+	test := `spec test1;
+
+	def tub = stock{
+		level: unknown(0, 10),
+		drain: unknown(1, 5, 2),
+	};
+
+	def faucet = flow{
+		water: new tub,
+		out: func{
+			water.level <- water.drain;
+		},
+	};
+
+	for 1 run {
+		drawn = new faucet;
+		drawn.out;
+	};
+	`
+	expecting := `(set-logic QF_NRA)
+	(declare-fun test1_drawn_water_level_0 () Real)
+	(declare-fun test1_drawn_water_drain_0 () Real)
+	(declare-fun test1_drawn_water_level_1 () Real)
+	(assert (and (>= test1_drawn_water_level_0 0.0) (<= test1_drawn_water_level_0 10.0)))
+	(assert (and (>= test1_drawn_water_drain_0 1.0) (<= test1_drawn_water_drain_0 5.0) (or (= test1_drawn_water_drain_0 1.0) (= test1_drawn_water_drain_0 3.0) (= test1_drawn_water_drain_0 5.0))))
+	(assert (= test1_drawn_water_level_1 (+ test1_drawn_water_level_0 test1_drawn_water_drain_0)))
+`
+
+	smt := prepTest("", test, true, false)
+
+	err := compareResults("UnknownRange", smt, expecting)
+
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+}
+
+func TestUncertainSupport(t *testing.T) {
+	test := `spec test1;
+
+	def tub = stock{
+		level: uncertain(uniform, 0, 10),
+		drain: uncertain(exponential, 2),
+		drips: uncertain(poisson, 1),
+		rate: uncertain(3, 1),
+	};
+
+	def faucet = flow{
+		water: new tub,
+		out: func{
+			water.level <- water.drain + water.drips + water.rate;
+		},
+	};
+
+	for 1 run {
+		drawn = new faucet;
+		drawn.out;
+	};
+	`
+	expecting := `(set-logic QF_NRA)
+	(declare-fun test1_drawn_water_level_0 () Real)
+	(declare-fun test1_drawn_water_drain_0 () Real)
+	(declare-fun test1_drawn_water_drips_0 () Real)
+	(declare-fun test1_drawn_water_rate_0 () Real)
+	(declare-fun test1_drawn_water_level_1 () Real)
+	(assert (and (>= test1_drawn_water_level_0 0.0) (<= test1_drawn_water_level_0 10.0)))
+	(assert (>= test1_drawn_water_drain_0 0.0))
+	(assert (and (>= test1_drawn_water_drips_0 0.0) (<= test1_drawn_water_drips_0 21.0) (or (= test1_drawn_water_drips_0 0.0) (= test1_drawn_water_drips_0 1.0) (= test1_drawn_water_drips_0 2.0) (= test1_drawn_water_drips_0 3.0) (= test1_drawn_water_drips_0 4.0) (= test1_drawn_water_drips_0 5.0) (= test1_drawn_water_drips_0 6.0) (= test1_drawn_water_drips_0 7.0) (= test1_drawn_water_drips_0 8.0) (= test1_drawn_water_drips_0 9.0) (= test1_drawn_water_drips_0 10.0) (= test1_drawn_water_drips_0 11.0) (= test1_drawn_water_drips_0 12.0) (= test1_drawn_water_drips_0 13.0) (= test1_drawn_water_drips_0 14.0) (= test1_drawn_water_drips_0 15.0) (= test1_drawn_water_drips_0 16.0) (= test1_drawn_water_drips_0 17.0) (= test1_drawn_water_drips_0 18.0) (= test1_drawn_water_drips_0 19.0) (= test1_drawn_water_drips_0 20.0) (= test1_drawn_water_drips_0 21.0))))
+	(assert (= test1_drawn_water_level_1 (+ test1_drawn_water_level_0 (+ (+ test1_drawn_water_drain_0 test1_drawn_water_drips_0) test1_drawn_water_rate_0))))
+`
+
+	smt := prepTest("", test, true, false)
+
+	err := compareResults("UncertainSupport", smt, expecting)
+
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+}
+
 func TestEventuallyAlways(t *testing.T) {
 	test := `spec test1;
 	
@@ -95,14 +218,14 @@ func TestEventuallyAlways(t *testing.T) {
 	(assert (= test1_t_foo_value_3 (- test1_t_foo_value_2 2.0)))
 	(assert (= test1_t_foo_value_4 (- test1_t_foo_value_3 2.0)))
 	(assert (= test1_t_foo_value_5 (- test1_t_foo_value_4 2.0)))
-	(assert (or 
+	(assert (! (or 
 		(and (> test1_t_foo_value_0 0) (> test1_t_foo_value_1 0)(> test1_t_foo_value_2 0)(> test1_t_foo_value_3 0)(> test1_t_foo_value_4 0)(> test1_t_foo_value_5 0))
 		(and (> test1_t_foo_value_1 0)(> test1_t_foo_value_2 0)(> test1_t_foo_value_3 0)(> test1_t_foo_value_4 0)(> test1_t_foo_value_5 0))
 		(and (> test1_t_foo_value_2 0)(> test1_t_foo_value_3 0)(> test1_t_foo_value_4 0)(> test1_t_foo_value_5 0))
 		(and (> test1_t_foo_value_3 0)(> test1_t_foo_value_4 0)(> test1_t_foo_value_5 0))
 		(and (> test1_t_foo_value_4 0)(> test1_t_foo_value_5 0))
 		(and (> test1_t_foo_value_5 0))
-		))
+		) :named assume_0))
 `
 
 	smt := prepTest("", test, true, false)
@@ -148,14 +271,14 @@ func TestEventuallyAlways2(t *testing.T) {
 	(assert (= test1_t_foo_value_3 (- test1_t_foo_value_2 2.0)))
 	(assert (= test1_t_foo_value_4 (- test1_t_foo_value_3 2.0)))
 	(assert (= test1_t_foo_value_5 (- test1_t_foo_value_4 2.0)))
-	(assert (or 
+	(assert (! (or 
 		(and (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_1 0)(<= test1_t_foo_value_2 0)(<= test1_t_foo_value_3 0)(<= test1_t_foo_value_4 0)(<= test1_t_foo_value_5 0))
 		(and (<= test1_t_foo_value_1 0)(<= test1_t_foo_value_2 0)(<= test1_t_foo_value_3 0)(<= test1_t_foo_value_4 0)(<= test1_t_foo_value_5 0))
 		(and (<= test1_t_foo_value_2 0)(<= test1_t_foo_value_3 0)(<= test1_t_foo_value_4 0)(<= test1_t_foo_value_5 0))
 		(and (<= test1_t_foo_value_3 0)(<= test1_t_foo_value_4 0)(<= test1_t_foo_value_5 0))
 		(and (<= test1_t_foo_value_4 0)(<= test1_t_foo_value_5 0))
 		(and (<= test1_t_foo_value_5 0))
-		))
+		) :named assert_0))
 `
 
 	smt := prepTest("", test, true, false)
@@ -200,7 +323,7 @@ func TestTemporal(t *testing.T) {
 	(assert (= test1_t_foo_value_3 (- test1_t_foo_value_2 2.0)))
 	(assert (= test1_t_foo_value_4 (- test1_t_foo_value_3 2.0)))
 	(assert (= test1_t_foo_value_5 (- test1_t_foo_value_4 2.0)))
-	(assert (or (and (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_1 0)) (and (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_2 0)) (and (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_3 0)) (and (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_4 0)) (and (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_5 0)) (and (<= test1_t_foo_value_1 0) (<= test1_t_foo_value_2 0)) (and (<= test1_t_foo_value_1 0) (<= test1_t_foo_value_3 0)) (and (<= test1_t_foo_value_1 0) (<= test1_t_foo_value_4 0)) (and (<= test1_t_foo_value_1 0) (<= test1_t_foo_value_5 0)) (and (<= test1_t_foo_value_2 0) (<= test1_t_foo_value_3 0)) (and (<= test1_t_foo_value_2 0) (<= test1_t_foo_value_4 0)) (and (<= test1_t_foo_value_2 0) (<= test1_t_foo_value_5 0)) (and (<= test1_t_foo_value_3 0) (<= test1_t_foo_value_4 0)) (and (<= test1_t_foo_value_3 0) (<= test1_t_foo_value_5 0)) (and (<= test1_t_foo_value_4 0) (<= test1_t_foo_value_5 0))))
+	(assert (! (or (and (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_1 0)) (and (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_2 0)) (and (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_3 0)) (and (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_4 0)) (and (<= test1_t_foo_value_0 0) (<= test1_t_foo_value_5 0)) (and (<= test1_t_foo_value_1 0) (<= test1_t_foo_value_2 0)) (and (<= test1_t_foo_value_1 0) (<= test1_t_foo_value_3 0)) (and (<= test1_t_foo_value_1 0) (<= test1_t_foo_value_4 0)) (and (<= test1_t_foo_value_1 0) (<= test1_t_foo_value_5 0)) (and (<= test1_t_foo_value_2 0) (<= test1_t_foo_value_3 0)) (and (<= test1_t_foo_value_2 0) (<= test1_t_foo_value_4 0)) (and (<= test1_t_foo_value_2 0) (<= test1_t_foo_value_5 0)) (and (<= test1_t_foo_value_3 0) (<= test1_t_foo_value_4 0)) (and (<= test1_t_foo_value_3 0) (<= test1_t_foo_value_5 0)) (and (<= test1_t_foo_value_4 0) (<= test1_t_foo_value_5 0))) :named assert_0))
 	`
 
 	smt := prepTest("", test, true, false)
@@ -251,9 +374,9 @@ func TestTemporal2(t *testing.T) {
 (assert (= test1_t_u_x_2 (+ test1_t_u_x_1 (+ test1_a_0 test1_b_0))))
 (assert (= test1_t_u_x_3 (+ test1_t_u_x_2 (+ test1_a_0 test1_b_0))))
 (assert (= test1_t_u_x_4 (+ test1_t_u_x_3 (+ test1_a_0 test1_b_0))))
-(assert (= test1_t_u_x_5 (+ test1_t_u_x_4 (+ test1_a_0 test1_b_0))))(assert (and (not (= test1_t_u_x_0 11)) (not (= test1_t_u_x_1 11)) (not (= test1_t_u_x_2 11)) (not (= test1_t_u_x_3 11)) (not (= test1_t_u_x_4 11)) (not (= test1_t_u_x_5 11))))
-(assert (or (and (>= test1_t_u_x_0 2) (< test1_t_u_x_0 10)) (and (>= test1_t_u_x_1 2) (< test1_t_u_x_1 10)) (and (>= test1_t_u_x_2 2) (< test1_t_u_x_2 10)) (and (>= test1_t_u_x_3 2) (< test1_t_u_x_3 10)) (and (>= test1_t_u_x_4 2) (< test1_t_u_x_4 10)) (and (>= test1_t_u_x_5 2) (< test1_t_u_x_5 10))))
-(assert (or (and (or (= test1_t_u_x_0 2) (= test1_t_u_x_1 2)) (and (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_4 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_0 2) (= test1_t_u_x_2 2)) (and (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_4 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_0 2) (= test1_t_u_x_3 2)) (and (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_4 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_0 2) (= test1_t_u_x_4 2)) (and (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_0 2) (= test1_t_u_x_5 2)) (and (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_4 2)))) (and (or (= test1_t_u_x_1 2) (= test1_t_u_x_2 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_4 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_1 2) (= test1_t_u_x_3 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_4 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_1 2) (= test1_t_u_x_4 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_1 2) (= test1_t_u_x_5 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_4 2)))) (and (or (= test1_t_u_x_2 2) (= test1_t_u_x_3 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_4 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_2 2) (= test1_t_u_x_4 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_2 2) (= test1_t_u_x_5 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_4 2)))) (and (or (= test1_t_u_x_3 2) (= test1_t_u_x_4 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_3 2) (= test1_t_u_x_5 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_4 2)))) (and (or (= test1_t_u_x_4 2) (= test1_t_u_x_5 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_3 2))))))`
+(assert (= test1_t_u_x_5 (+ test1_t_u_x_4 (+ test1_a_0 test1_b_0))))(assert (! (and (not (= test1_t_u_x_0 11)) (not (= test1_t_u_x_1 11)) (not (= test1_t_u_x_2 11)) (not (= test1_t_u_x_3 11)) (not (= test1_t_u_x_4 11)) (not (= test1_t_u_x_5 11))) :named assert_0))
+(assert (! (or (and (>= test1_t_u_x_0 2) (< test1_t_u_x_0 10)) (and (>= test1_t_u_x_1 2) (< test1_t_u_x_1 10)) (and (>= test1_t_u_x_2 2) (< test1_t_u_x_2 10)) (and (>= test1_t_u_x_3 2) (< test1_t_u_x_3 10)) (and (>= test1_t_u_x_4 2) (< test1_t_u_x_4 10)) (and (>= test1_t_u_x_5 2) (< test1_t_u_x_5 10))) :named assume_0))
+(assert (! (or (and (or (= test1_t_u_x_0 2) (= test1_t_u_x_1 2)) (and (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_4 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_0 2) (= test1_t_u_x_2 2)) (and (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_4 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_0 2) (= test1_t_u_x_3 2)) (and (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_4 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_0 2) (= test1_t_u_x_4 2)) (and (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_0 2) (= test1_t_u_x_5 2)) (and (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_4 2)))) (and (or (= test1_t_u_x_1 2) (= test1_t_u_x_2 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_4 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_1 2) (= test1_t_u_x_3 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_4 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_1 2) (= test1_t_u_x_4 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_1 2) (= test1_t_u_x_5 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_4 2)))) (and (or (= test1_t_u_x_2 2) (= test1_t_u_x_3 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_4 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_2 2) (= test1_t_u_x_4 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_2 2) (= test1_t_u_x_5 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_3 2)) (not (= test1_t_u_x_4 2)))) (and (or (= test1_t_u_x_3 2) (= test1_t_u_x_4 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_5 2)))) (and (or (= test1_t_u_x_3 2) (= test1_t_u_x_5 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_4 2)))) (and (or (= test1_t_u_x_4 2) (= test1_t_u_x_5 2)) (and (not (= test1_t_u_x_0 2)) (not (= test1_t_u_x_1 2)) (not (= test1_t_u_x_2 2)) (not (= test1_t_u_x_3 2))))) :named assume_1))`
 
 	smt := prepTest("", test, true, false)
 
@@ -325,7 +448,7 @@ func TestTemporalSys(t *testing.T) {
 	(assert (ite (= test1_a_zoo_1 true) (and (= test1_a_foo_4 test1_a_foo_3) (= test1_a_zoo_3 test1_a_zoo_2)) (and (= test1_a_foo_4 test1_a_foo_2) (= test1_a_zoo_3 test1_a_zoo_1))))
 	(assert (= test1_a_foo_5 true))
 	(assert (= test1_b_buzz_2 false))
-	(assert (ite (= test1_b_buzz_1 true) (and (= test1_a_foo_6 test1_a_foo_5) (= test1_b_buzz_3 test1_b_buzz_2)) (and (= test1_a_foo_6 test1_a_foo_4) (= test1_b_buzz_3 test1_b_buzz_1))))(assert (and (or test1_a_zoo_0 test1_b_bar_0) (or test1_a_zoo_1 test1_b_bar_0) (or test1_a_zoo_1 test1_b_bar_1) (or test1_a_zoo_1 test1_b_bar_2) (or test1_a_zoo_2 test1_b_bar_2) (or test1_a_zoo_3 test1_b_bar_2)))
+	(assert (ite (= test1_b_buzz_1 true) (and (= test1_a_foo_6 test1_a_foo_5) (= test1_b_buzz_3 test1_b_buzz_2)) (and (= test1_a_foo_6 test1_a_foo_4) (= test1_b_buzz_3 test1_b_buzz_1))))(assert (! (and (or test1_a_zoo_0 test1_b_bar_0) (or test1_a_zoo_1 test1_b_bar_0) (or test1_a_zoo_1 test1_b_bar_1) (or test1_a_zoo_1 test1_b_bar_2) (or test1_a_zoo_2 test1_b_bar_2) (or test1_a_zoo_3 test1_b_bar_2)) :named assert_0))
 	`
 
 	smt := prepTest("", test, false, false)
@@ -422,7 +545,7 @@ func TestTemporalSys2(t *testing.T) {
 	(assert (ite (= test1_a_zoo_3 true) (and (= test1_a_foo_10 test1_a_foo_9) (= test1_a_zoo_5 test1_a_zoo_4)) (and (= test1_a_foo_10 test1_a_foo_8) (= test1_a_zoo_5 test1_a_zoo_3))))
 	(assert (= test1_a_foo_11 true))
 	(assert (= test1_b_buzz_4 false))
-	(assert (ite (= test1_b_buzz_3 true) (and (= test1_a_foo_12 test1_a_foo_11) (= test1_b_buzz_5 test1_b_buzz_4)) (and (= test1_b_buzz_5 test1_b_buzz_3) (= test1_a_foo_12 test1_a_foo_10))))(assert (and (or (or test1_a_zoo_0 test1_b_buzz_0) (or (<= test1_b_bar_0 3) (not (= test1_a_foo_0 4)))) (or (or test1_a_zoo_1 test1_b_buzz_0) (or (<= test1_b_bar_0 3) (not (= test1_a_foo_0 4)))) (or (or test1_a_zoo_1 test1_b_buzz_1) (or (<= test1_b_bar_0 3) (not (= test1_a_foo_0 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_12 4))))))`
+	(assert (ite (= test1_b_buzz_3 true) (and (= test1_a_foo_12 test1_a_foo_11) (= test1_b_buzz_5 test1_b_buzz_4)) (and (= test1_b_buzz_5 test1_b_buzz_3) (= test1_a_foo_12 test1_a_foo_10))))(assert (! (and (or (or test1_a_zoo_0 test1_b_buzz_0) (or (<= test1_b_bar_0 3) (not (= test1_a_foo_0 4)))) (or (or test1_a_zoo_1 test1_b_buzz_0) (or (<= test1_b_bar_0 3) (not (= test1_a_foo_0 4)))) (or (or test1_a_zoo_1 test1_b_buzz_1) (or (<= test1_b_bar_0 3) (not (= test1_a_foo_0 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_2 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_3 test1_b_buzz_1) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_3 test1_b_buzz_2) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_1 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_1 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_2 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_3 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_4 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_5 4)))) (or (or test1_a_zoo_3 test1_b_buzz_3) (or (<= test1_b_bar_2 3) (not (= test1_a_foo_6 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_4 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_5 test1_b_buzz_3) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_5 test1_b_buzz_4) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_3 3) (not (= test1_a_foo_12 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_7 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_8 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_9 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_10 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_11 4)))) (or (or test1_a_zoo_5 test1_b_buzz_5) (or (<= test1_b_bar_4 3) (not (= test1_a_foo_12 4))))) :named assert_0))`
 
 	smt := prepTest("", test, false, false)
 
@@ -555,6 +678,121 @@ func TestMultiCond(t *testing.T) {
 
 }
 
+func TestDecisions(t *testing.T) {
+	test := `spec test1;
+
+	def amount = stock{
+		value: 10,
+	};
+
+	def test = flow{
+		foo: new amount,
+		bar: func{
+			foo.value -> 2;
+		},
+		baz: func{
+			if foo.value > 7 {
+				foo.value = foo.value * 2;
+			}
+		},
+	};
+
+	for 1 run {
+		t = new test;
+		t.bar | t.baz;
+	};
+	`
+	flags := map[string]bool{"specType": true, "testing": false, "skipRun": false}
+	l := listener.Execute(test, "", flags)
+	pre := preprocess.Execute(l)
+	ty := types.Execute(pre.Processed, pre.Specs)
+	compiler := llvm.Execute(ty.Checked, ty.SpecStructs, l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, true)
+	g := Execute(compiler)
+
+	d := g.GetDecisions()
+	if len(d) != 3 {
+		t.Fatalf("wrong number of decisions. want=3 got=%d", len(d))
+	}
+
+	run := d[2]
+	if !run.Parallel() || len(run.Orders) != 2 || len(run.Tests) != 2 {
+		t.Fatalf("parallel decision is incorrect. got=%+v", run)
+	}
+
+	if run.Orders[0][0] != "@test1_t_bar" || run.Orders[1][0] != "@test1_t_baz" {
+		t.Fatalf("parallel orders are incorrect. got=%v", run.Orders)
+	}
+
+	for i, c := range d[:2] {
+		if c.Parallel() || c.Function != "@test1_t_baz" || len(c.Tests) != 2 {
+			t.Fatalf("conditional decision %d is incorrect. got=%+v", i, c)
+		}
+
+		if c.Parent != run || c.Option != i {
+			t.Fatalf("conditional decision %d is not tied to option %d of the run. got=%+v", i, i, c)
+		}
+
+		if c.Tests[1] != fmt.Sprintf("(not %s)", c.Cond) {
+			t.Fatalf("conditional decision %d has the wrong false test. got=%s", i, c.Tests[1])
+		}
+	}
+}
+
+func TestRunRounds(t *testing.T) {
+	test := `spec test1;
+
+	const a = 2;
+
+	def amount = stock{
+		value: 10,
+	};
+
+	def test = flow{
+		foo: new amount,
+		bar: func{
+			foo.value -> a;
+		},
+	};
+
+	assert amount.value > 0;
+
+	for 2 run {
+		t = new test;
+		t.bar;
+	};
+	`
+	flags := map[string]bool{"specType": true, "testing": false, "skipRun": false}
+	for _, c := range []struct {
+		spec   string
+		rounds []int
+	}{
+		{test, []int{0, 1, 2}},
+		{strings.NewReplacer("const a = 2;", "", "-> a;", "-> 2;").Replace(test), []int{1, 2}},
+	} {
+		l := listener.Execute(c.spec, "", flags)
+		pre := preprocess.Execute(l)
+		ty := types.Execute(pre.Processed, pre.Specs)
+		compiler := llvm.Execute(ty.Checked, ty.SpecStructs, l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, true)
+		g := Execute(compiler)
+
+		// The constant is set before the run block,
+		// so it gets a round of its own
+		if fmt.Sprint(g.RunRounds) != fmt.Sprint(c.rounds) {
+			t.Fatalf("run rounds are incorrect. want=%v got=%v", c.rounds, g.RunRounds)
+		}
+
+		if len(g.RoundVars) != len(g.RunRounds) {
+			t.Fatalf("run rounds don't line up with round vars. want=%d got=%d", len(g.RoundVars), len(g.RunRounds))
+		}
+
+		for _, s := range g.RVarLookup["test1_t_foo_value"] {
+			if g.RunRounds[s[1]] == 0 {
+				t.Fatalf("test1_t_foo_value_%d is set before the run block", s[0])
+			}
+		}
+	}
+}
+
 func compareResults(s string, smt string, expecting string) error {
 	if !strings.Contains(smt, "(declare-fun") {
 		return fmt.Errorf("smt not valid for spec %s. \ngot=%s", s, smt)
@@ -606,7 +844,7 @@ func prepTest(filepath string, test string, specType bool, testRun bool) string
 	l := listener.Execute(test, path, flags)
 	pre := preprocess.Execute(l)
 	ty := types.Execute(pre.Processed, pre.Specs)
-	compiler := llvm.Execute(ty.Checked, ty.SpecStructs, l.Uncertains, l.Unknowns, true)
+	compiler := llvm.Execute(ty.Checked, ty.SpecStructs, l.Uncertains, l.Distributions, l.Unknowns, l.Ranges, true)
 
 	//fmt.Println(compiler.GetIR())
 	generator := Execute(compiler)
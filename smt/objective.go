@@ -0,0 +1,88 @@
+package smt
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Optional objective for solvers that optimize (z3). Rather
+// than an arbitrary failure the solver is asked for the one
+// where uncertain values are as close to their means as
+// possible, measured in sigmas:
+//
+//	minimize sum(|x - mean| / sigma)
+//
+// This stands in for the squared distance, which would rank
+// failures the same way as a log-likelihood for normals. The
+// absolute value keeps the objective linear (squares put z3's
+// optimizer in nonlinear arithmetic, where it often gives up
+// with unknown), at the cost of weighing one far-out value
+// less than squares would. It's written as an ite rather than
+// with extra variables so the model only has spec values.
+
+func (g *Generator) Objective() string {
+	var keys []string
+	for k := range g.Uncertains {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var terms []string
+	for _, k := range keys {
+		u := g.Uncertains[k]
+		if len(u) < 2 || u[1] == 0 { // No spread, nothing to minimize
+			continue
+		}
+
+		if g.variables.Types[k] == "Bool" { // Bernoulli, no distance to speak of
+			continue
+		}
+
+		id, ok := g.firstState(k)
+		if !ok {
+			continue
+		}
+
+		distance := fmt.Sprintf("(/ (- %s %s) %s)", id, smtFloat(u[0]), smtFloat(u[1]))
+		terms = append(terms, fmt.Sprintf("(ite (>= %s 0.0) %s (- %s))", distance, distance, distance))
+	}
+
+	switch len(terms) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("(minimize %s)", terms[0])
+	default:
+		return fmt.Sprintf("(minimize (+ %s))", strings.Join(terms, " "))
+	}
+}
+
+func (g *Generator) firstState(base string) (string, bool) {
+	// The uncertain value itself is the first state,
+	// everything after is calculated from it.
+	states := g.RVarLookup[base]
+	if len(states) == 0 {
+		return "", false
+	}
+
+	first := states[0][0]
+	for _, s := range states {
+		if s[0] < first {
+			first = s[0]
+		}
+	}
+	return fmt.Sprintf("%s_%d", base, first), true
+}
+
+func smtFloat(f float64) string {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s = s + ".0"
+	}
+	if f < 0 {
+		return fmt.Sprintf("(- %s)", s[1:])
+	}
+	return s
+}
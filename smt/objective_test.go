@@ -0,0 +1,52 @@
+package smt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestObjective(t *testing.T) {
+	g := NewGenerator()
+	g.Uncertains = map[string][]float64{
+		"test_rate":  {10, 2},
+		"test_drift": {-1.5, 0.5},
+		"test_fixed": {3, 0},
+	}
+	g.addVarToRound("test_rate", 0)
+	g.addVarToRound("test_rate", 1)
+	g.addVarToRound("test_drift", 2)
+	g.addVarToRound("test_fixed", 0)
+
+	expecting := `(minimize (+ (ite (>= (/ (- test_drift_2 (- 1.5)) 0.5) 0.0) (/ (- test_drift_2 (- 1.5)) 0.5) (- (/ (- test_drift_2 (- 1.5)) 0.5))) (ite (>= (/ (- test_rate_0 10.0) 2.0) 0.0) (/ (- test_rate_0 10.0) 2.0) (- (/ (- test_rate_0 10.0) 2.0)))))`
+	if g.Objective() != expecting {
+		t.Fatalf("objective is incorrect. want=%s got=%s", expecting, g.Objective())
+	}
+
+	g.Optimize = true
+	if !strings.HasSuffix(g.SMT(), expecting) {
+		t.Fatalf("objective missing from SMT. got=%s", g.SMT())
+	}
+}
+
+func TestObjectiveEmpty(t *testing.T) {
+	g := NewGenerator()
+	g.Optimize = true
+	if g.Objective() != "" {
+		t.Fatalf("objective generated with no uncertain values. got=%s", g.Objective())
+	}
+
+	if g.SMT() != "(set-logic QF_NRA)" {
+		t.Fatalf("empty objective changed the SMT. got=%s", g.SMT())
+	}
+}
+
+func TestObjectiveSkipsBool(t *testing.T) {
+	g := NewGenerator()
+	g.Uncertains = map[string][]float64{"test_open": {0.2, 0.4}}
+	g.variables.Types["test_open"] = "Bool"
+	g.addVarToRound("test_open", 0)
+
+	if g.Objective() != "" {
+		t.Fatalf("bernoulli value added to the objective. got=%s", g.Objective())
+	}
+}
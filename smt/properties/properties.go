@@ -0,0 +1,41 @@
+package properties
+
+import "fmt"
+
+// An assert from the spec as it was written into the
+// SMT. The generator negates asserts, so the Rule is
+// true when the assert is violated. Clauses are the
+// parts of the Rule that can be violated on their own
+// (one per state for most asserts) and Rounds is the
+// round of the run block each clause belongs to.
+type Property struct {
+	Name     string // :named in the SMT
+	Label    string // for asserts Fault wrote itself, see -auto-props
+	Position []int  // line and column of the assert
+	Assume   bool
+	Temporal bool // has a temporal filter, so it isn't a plain invariant
+	Rule     string
+	Clauses  []string
+	Rounds   []int
+	// The when of a when/then assert, if it can't
+	// be true the assert is vacuous
+	Antecedent string
+}
+
+func (p *Property) Line() int {
+	if len(p.Position) == 0 {
+		return 0
+	}
+	return p.Position[0]
+}
+
+func (p *Property) Round(clause int) int {
+	if clause < 0 || clause >= len(p.Rounds) {
+		return 0
+	}
+	return p.Rounds[clause]
+}
+
+func (p *Property) Named() string {
+	return fmt.Sprintf("(! %s :named %s)", p.Rule, p.Name)
+}
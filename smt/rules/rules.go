@@ -102,11 +102,12 @@ func (c *Choices) Tag(k1 string, k2 string) {
 
 type Infix struct {
 	Rule
-	X   Rule
-	Y   Rule
-	Ty  string
-	Op  string
-	tag *branch
+	X    Rule
+	Y    Rule
+	Ty   string
+	Op   string
+	Free bool // X has no value, the solver picks it
+	tag  *branch
 }
 
 func (i *Infix) ruleNode() {}
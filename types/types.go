@@ -185,6 +185,16 @@ func (c *Checker) typecheck(n ast.Node) (ast.Node, error) {
 		if valtype.Type != "BOOL" {
 			return nil, fmt.Errorf("assert statement not testing a Boolean expression. got=%s", valtype.Type)
 		}
+
+		if node.Until != nil {
+			n, err = c.inferFunction(node.Until)
+			if err != nil {
+				return node, err
+			}
+			if typeable(n).Type != "BOOL" {
+				return nil, fmt.Errorf("until is not a Boolean expression. got=%s", typeable(n).Type)
+			}
+		}
 		return node, err
 
 	case *ast.ForStatement:
@@ -776,10 +786,19 @@ func (c *Checker) inferScope(fl float64) int64 {
 }
 
 func (c *Checker) inferUncertain(node *ast.Uncertain) []ast.Type {
-	return []ast.Type{
-		{Type: "MEAN", Scope: c.inferScope(node.Mean), Parameters: nil},
-		{Type: "SIGMA", Scope: c.inferScope(node.Sigma), Parameters: nil},
+	names, ok := util.DistributionParams[node.Distribution]
+	if !ok || node.Distribution == "normal" || len(names) != len(node.Params) {
+		return []ast.Type{
+			{Type: "MEAN", Scope: c.inferScope(node.Mean), Parameters: nil},
+			{Type: "SIGMA", Scope: c.inferScope(node.Sigma), Parameters: nil},
+		}
+	}
+
+	var params []ast.Type
+	for i, p := range node.Params {
+		params = append(params, ast.Type{Type: names[i], Scope: c.inferScope(p), Parameters: nil})
 	}
+	return params
 }
 
 func (c *Checker) lookupReference(base ast.Node) (ast.Node, error) {
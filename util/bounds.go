@@ -0,0 +1,57 @@
+package util
+
+import (
+	"fmt"
+	"math"
+)
+
+// Bounds on an unknown value, ie unknown(0, 10) or
+// unknown(0, 10, 2). A step is written out as one
+// equality per value so it can't be too fine.
+
+const MaxSteps = 1000
+
+func ValidateRange(r []float64) error {
+	if len(r) != 2 && len(r) != 3 {
+		return fmt.Errorf("unknown takes a min, max and optional step got=%d values", len(r))
+	}
+
+	for _, v := range r {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("invalid bound for unknown got=%f", v)
+		}
+	}
+
+	if r[0] >= r[1] {
+		return fmt.Errorf("min of an unknown must be less than max got=%f,%f", r[0], r[1])
+	}
+
+	if len(r) == 3 {
+		if r[2] <= 0 {
+			return fmt.Errorf("step of an unknown must be positive got=%f", r[2])
+		}
+
+		if (r[1]-r[0])/r[2] > MaxSteps {
+			return fmt.Errorf("step of an unknown is too small, at most %d values are allowed got=%f", MaxSteps, (r[1]-r[0])/r[2])
+		}
+	}
+	return nil
+}
+
+func RangeSteps(r []float64) []float64 {
+	// Every value a stepped unknown can take, ie
+	// unknown(0, 10, 2.5) -> 0, 2.5, 5, 7.5, 10
+	if len(r) != 3 || r[2] <= 0 {
+		return nil
+	}
+
+	var steps []float64
+	for i := 0; ; i++ {
+		v := r[0] + float64(i)*r[2]
+		if v > r[1]+r[2]*1e-9 {
+			break
+		}
+		steps = append(steps, v)
+	}
+	return steps
+}
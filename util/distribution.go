@@ -0,0 +1,90 @@
+package util
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Distributions an uncertain value can be drawn from,
+// ie uncertain(uniform, 0, 10). Without a name the
+// two values are the mean and sigma of a normal.
+
+type Distribution interface {
+	Prob(x float64) float64
+	LogProb(x float64) float64
+	Mean() float64
+	StdDev() float64
+}
+
+var DistributionParams = map[string][]string{
+	"normal":      {"MEAN", "SIGMA"},
+	"uniform":     {"MIN", "MAX"},
+	"exponential": {"RATE"},
+	"poisson":     {"LAMBDA"},
+	"lognormal":   {"MU", "SIGMA"},
+	"beta":        {"ALPHA", "BETA"},
+	"bernoulli":   {"P"},
+}
+
+func IsDistribution(name string) bool {
+	_, ok := DistributionParams[name]
+	return ok
+}
+
+func NewDistribution(name string, params []float64) (Distribution, error) {
+	names, ok := DistributionParams[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown distribution %s", name)
+	}
+
+	if len(params) != len(names) {
+		return nil, fmt.Errorf("distribution %s takes %d parameters got=%d", name, len(names), len(params))
+	}
+
+	for _, p := range params {
+		if math.IsNaN(p) || math.IsInf(p, 0) {
+			return nil, fmt.Errorf("invalid parameter for distribution %s got=%f", name, p)
+		}
+	}
+
+	switch name {
+	case "normal":
+		if params[1] <= 0 {
+			return nil, fmt.Errorf("sigma of a normal distribution must be positive got=%f", params[1])
+		}
+		return distuv.Normal{Mu: params[0], Sigma: params[1]}, nil
+	case "uniform":
+		if params[0] >= params[1] {
+			return nil, fmt.Errorf("min of a uniform distribution must be less than max got=%f,%f", params[0], params[1])
+		}
+		return distuv.Uniform{Min: params[0], Max: params[1]}, nil
+	case "exponential":
+		if params[0] <= 0 {
+			return nil, fmt.Errorf("rate of an exponential distribution must be positive got=%f", params[0])
+		}
+		return distuv.Exponential{Rate: params[0]}, nil
+	case "poisson":
+		if params[0] <= 0 {
+			return nil, fmt.Errorf("lambda of a poisson distribution must be positive got=%f", params[0])
+		}
+		return distuv.Poisson{Lambda: params[0]}, nil
+	case "lognormal":
+		if params[1] <= 0 {
+			return nil, fmt.Errorf("sigma of a lognormal distribution must be positive got=%f", params[1])
+		}
+		return distuv.LogNormal{Mu: params[0], Sigma: params[1]}, nil
+	case "beta":
+		if params[0] <= 0 || params[1] <= 0 {
+			return nil, fmt.Errorf("alpha and beta of a beta distribution must be positive got=%f,%f", params[0], params[1])
+		}
+		return distuv.Beta{Alpha: params[0], Beta: params[1]}, nil
+	case "bernoulli":
+		if params[0] < 0 || params[0] > 1 {
+			return nil, fmt.Errorf("p of a bernoulli distribution must be between 0 and 1 got=%f", params[0])
+		}
+		return distuv.Bernoulli{P: params[0]}, nil
+	}
+	return nil, fmt.Errorf("unknown distribution %s", name)
+}
@@ -0,0 +1,48 @@
+package util
+
+import "strings"
+
+func SexprList(s string) []string {
+	// Splits the top level of an s-expression, ie
+	// ((a 1.0) (b (- 2.0))) -> [(a 1.0), (b (- 2.0))]
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+		return nil
+	}
+	s = s[1 : len(s)-1]
+
+	var items []string
+	var depth int
+	start := -1
+	for i, ch := range s {
+		switch {
+		case ch == '(':
+			if depth == 0 {
+				if start != -1 { // atom right up against a list
+					items = append(items, s[start:i])
+				}
+				start = i
+			}
+			depth++
+		case ch == ')':
+			depth--
+			if depth == 0 {
+				items = append(items, s[start:i+1])
+				start = -1
+			}
+		case ch == ' ' || ch == '\n' || ch == '\t' || ch == '\r':
+			if depth == 0 && start != -1 {
+				items = append(items, s[start:i])
+				start = -1
+			}
+		default:
+			if depth == 0 && start == -1 {
+				start = i
+			}
+		}
+	}
+	if start != -1 {
+		items = append(items, s[start:])
+	}
+	return items
+}
@@ -387,6 +387,15 @@ func Intersection(s1 []string, s2 []string, init bool) []string {
 	return s3
 }
 
+func SpecName(id string) string {
+	// test1_t_foo_value is t.foo.value in the spec
+	parts := strings.Split(id, "_")
+	if len(parts) > 1 {
+		parts = parts[1:]
+	}
+	return strings.Join(parts, ".")
+}
+
 func FromEnd(str string, offset int) string {
 	if len(str) < offset {
 		return str
@@ -584,3 +584,98 @@ func TestFromEnd(t *testing.T) {
 		t.Fatal("FromEnd produces wrong substring")
 	}
 }
+
+func TestNewDistribution(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []float64
+		mean   float64
+	}{
+		{"normal", []float64{10, 2}, 10},
+		{"uniform", []float64{0, 10}, 5},
+		{"exponential", []float64{2}, 0.5},
+		{"poisson", []float64{3}, 3},
+		{"beta", []float64{2, 2}, 0.5},
+		{"bernoulli", []float64{0.2}, 0.2},
+	}
+
+	for _, test := range tests {
+		d, err := NewDistribution(test.name, test.params)
+		if err != nil {
+			t.Fatalf("distribution %s not created. got=%s", test.name, err)
+		}
+
+		if d.Mean() != test.mean {
+			t.Fatalf("distribution %s has the wrong mean. want=%f got=%f", test.name, test.mean, d.Mean())
+		}
+	}
+
+	_, err := NewDistribution("uniform", []float64{10, 0})
+	if err == nil {
+		t.Fatal("uniform distribution with min > max did not return an error")
+	}
+
+	_, err = NewDistribution("bernoulli", []float64{0.2, 0.3})
+	if err == nil {
+		t.Fatal("distribution with too many parameters did not return an error")
+	}
+
+	_, err = NewDistribution("cauchy", []float64{0, 1})
+	if err == nil {
+		t.Fatal("unknown distribution did not return an error")
+	}
+}
+
+func TestValidateRange(t *testing.T) {
+	err := ValidateRange([]float64{0, 10})
+	if err != nil {
+		t.Fatalf("valid range returned an error. got=%s", err)
+	}
+
+	err = ValidateRange([]float64{0, 10, 2.5})
+	if err != nil {
+		t.Fatalf("valid stepped range returned an error. got=%s", err)
+	}
+
+	err = ValidateRange([]float64{10, 0})
+	if err == nil {
+		t.Fatal("range with min > max did not return an error")
+	}
+
+	err = ValidateRange([]float64{0, 10, 0})
+	if err == nil {
+		t.Fatal("range with a zero step did not return an error")
+	}
+
+	err = ValidateRange([]float64{0, 10, 0.001})
+	if err == nil {
+		t.Fatal("range with too many steps did not return an error")
+	}
+
+	steps := RangeSteps([]float64{0, 10, 2.5})
+	if len(steps) != 5 || steps[0] != 0 || steps[4] != 10 {
+		t.Fatalf("range steps are incorrect. got=%v", steps)
+	}
+
+	if RangeSteps([]float64{0, 10}) != nil {
+		t.Fatal("range without a step returned steps")
+	}
+}
+
+func TestSexprList(t *testing.T) {
+	got := SexprList("((a 1.0) (b (- 2.0)) c)")
+	expected := []string{"(a 1.0)", "(b (- 2.0))", "c"}
+	if len(got) != len(expected) {
+		t.Fatalf("s-expression split incorrectly. want=%s got=%s", expected, got)
+	}
+
+	for i, e := range expected {
+		if got[i] != e {
+			t.Fatalf("s-expression split incorrectly. want=%s got=%s", e, got[i])
+		}
+	}
+
+	if SexprList("sat") != nil {
+		t.Fatal("atom split as an s-expression")
+	}
+}